@@ -0,0 +1,220 @@
+package jamf_pro_go
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+
+	"github.com/google/go-querystring/query"
+)
+
+const (
+	APIVersionCategories = "v1"
+	APIPathCategories    = "categories"
+)
+
+type Categories struct {
+	TotalCount uint32     `json:"totalCount"`
+	Results    []Category `json:"results"`
+}
+
+type Category struct {
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+	Priority int32  `json:"priority"` // 1 to 20, default: 9
+}
+
+type CategoryParams struct {
+	Name     string `json:"name"`
+	Priority int32  `json:"priority,omitempty"`
+}
+
+type CreateCategoryResult struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+type GetCategoriesOpts = PageOpts
+
+func (c *Client) GetCategories(opts GetCategoriesOpts) (*Categories, error) {
+	var result Categories
+
+	v, err := query.Values(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.call(path.Join(APIVersionCategories, APIPathCategories), http.MethodGet,
+		APIVersionCategories, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+type CategoryPager struct {
+	*Pager
+	page *Categories
+}
+
+// Categories returns the categories of the current page.
+func (p *CategoryPager) Categories() []Category {
+	if p.page == nil {
+		return nil
+	}
+	return p.page.Results
+}
+
+// PageCategories returns a pager over all categories matching opts.
+func (c *Client) PageCategories(opts PageOpts) *CategoryPager {
+	p := &CategoryPager{}
+	p.Pager = newPager(opts, func(opts PageOpts) (int, uint32, error) {
+		page, err := c.GetCategories(opts)
+		if err != nil {
+			return 0, 0, err
+		}
+		p.page = page
+		return len(page.Results), page.TotalCount, nil
+	})
+	return p
+}
+
+func (c *Client) GetCategory(categoryID uint32) (*Category, error) {
+	var result Category
+
+	err := c.call(path.Join(APIVersionCategories, APIPathCategories, fmt.Sprint(categoryID)), http.MethodGet,
+		APIVersionCategories, nil, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// FindCategoryByName returns the category with the given name, or nil if
+// there is none.
+func (c *Client) FindCategoryByName(name string) (*Category, error) {
+	categories, err := c.GetCategories(GetCategoriesOpts{
+		PageSize: 1,
+		Filter:   "name==" + rsqlQuote(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(categories.Results) == 0 {
+		return nil, nil
+	}
+	return &categories.Results[0], nil
+}
+
+// CategoryID resolves the name of a category to its ID, e.g. to fill in
+// ScriptParams.CategoryID.
+func (c *Client) CategoryID(name string) (string, error) {
+	category, err := c.FindCategoryByName(name)
+	if err != nil {
+		return "", err
+	}
+	if category == nil {
+		return "", fmt.Errorf("[jamf-pro-go] no category is named %q", name)
+	}
+	return category.ID, nil
+}
+
+func (c *Client) CreateCategory(params CategoryParams) (*CreateCategoryResult, error) {
+	var result CreateCategoryResult
+
+	err := c.call(path.Join(APIVersionCategories, APIPathCategories), http.MethodPost,
+		APIVersionCategories, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *Client) UpdateCategory(categoryID uint32, params CategoryParams) (*Category, error) {
+	var result Category
+
+	err := c.call(path.Join(APIVersionCategories, APIPathCategories, fmt.Sprint(categoryID)), http.MethodPut,
+		APIVersionCategories, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *Client) DeleteCategory(categoryID uint32) error {
+	err := c.call(path.Join(APIVersionCategories, APIPathCategories, fmt.Sprint(categoryID)), http.MethodDelete,
+		APIVersionCategories, nil, nil, nil)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	c.logf("[jamf-pro-go] Category (ID: %d) is deleted", categoryID)
+
+	return nil
+}
+
+type DeleteCategoriesParams struct {
+	IDs []string `json:"ids"`
+}
+
+// DeleteCategories deletes several categories in one request.
+func (c *Client) DeleteCategories(categoryIDs ...uint32) error {
+	if len(categoryIDs) == 0 {
+		return errors.New("[Err] missing category IDs")
+	}
+
+	params := DeleteCategoriesParams{IDs: make([]string, 0, len(categoryIDs))}
+	for _, id := range categoryIDs {
+		params.IDs = append(params.IDs, fmt.Sprint(id))
+	}
+
+	err := c.call(path.Join(APIVersionCategories, APIPathCategories, "delete-multiple"), http.MethodPost,
+		APIVersionCategories, nil, params, nil)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	c.logf("[jamf-pro-go] Categories (IDs: %v) are deleted", params.IDs)
+
+	return nil
+}
+
+func (c *Client) GetCategoryHistory(categoryID uint32, opts PageOpts) (*History, error) {
+	var result History
+
+	v, err := query.Values(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.call(path.Join(APIVersionCategories, APIPathCategories, fmt.Sprint(categoryID), "history"), http.MethodGet,
+		APIVersionCategories, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// PageCategoryHistory returns a pager over the history of the category.
+func (c *Client) PageCategoryHistory(categoryID uint32, opts PageOpts) *HistoryPager {
+	return newHistoryPager(opts, func(opts PageOpts) (*History, error) {
+		return c.GetCategoryHistory(categoryID, opts)
+	})
+}
+
+func (c *Client) AddCategoryHistoryNote(categoryID uint32, note string) (*CreateHistoryNoteResult, error) {
+	var result CreateHistoryNoteResult
+
+	err := c.call(path.Join(APIVersionCategories, APIPathCategories, fmt.Sprint(categoryID), "history"), http.MethodPost,
+		APIVersionCategories, nil, HistoryNoteParams{Note: note}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,97 @@
+package jamf_pro_go
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFindCategoryByName(t *testing.T) {
+	var filters []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/uapi/v1/categories" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		filter := r.URL.Query().Get("filter")
+		filters = append(filters, filter)
+		if filter == `name=="Tools"` {
+			fmt.Fprint(w, `{"totalCount": 1, "results": [{"id": "3", "name": "Tools", "priority": 9}]}`)
+			return
+		}
+		fmt.Fprint(w, `{"totalCount": 0, "results": []}`)
+	}))
+	defer srv.Close()
+	c := NewClient(&Config{BaseURL: srv.URL})
+
+	category, err := c.FindCategoryByName("Tools")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if category == nil || category.ID != "3" || category.Priority != 9 {
+		t.Errorf("FindCategoryByName(Tools) = %+v", category)
+	}
+
+	category, err = c.FindCategoryByName(`Say "hi"`)
+	if err != nil || category != nil {
+		t.Errorf("FindCategoryByName of a missing category = %+v, %v, want nil, nil", category, err)
+	}
+
+	id, err := c.CategoryID("Tools")
+	if err != nil || id != "3" {
+		t.Errorf("CategoryID(Tools) = %q, %v, want 3", id, err)
+	}
+	if _, err := c.CategoryID("Missing"); err == nil || !strings.Contains(err.Error(), `no category is named "Missing"`) {
+		t.Errorf("CategoryID(Missing) error = %v", err)
+	}
+
+	want := []string{`name=="Tools"`, `name=="Say \"hi\""`, `name=="Tools"`, `name=="Missing"`}
+	if strings.Join(filters, "\n") != strings.Join(want, "\n") {
+		t.Errorf("filters = %q, want %q", filters, want)
+	}
+}
+
+func TestDeleteCategories(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+	c := NewClient(&Config{BaseURL: srv.URL})
+
+	if err := c.DeleteCategories(); err == nil {
+		t.Error("DeleteCategories() without IDs: no error")
+	}
+	if err := c.DeleteCategories(3, 4); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DeleteCategory(5); err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`POST /uapi/v1/categories/delete-multiple {"ids":["3","4"]}`,
+		`DELETE /uapi/v1/categories/5 `,
+	}
+	if strings.Join(requests, "\n") != strings.Join(want, "\n") {
+		t.Errorf("requests = %q, want %q", requests, want)
+	}
+}
+
+func TestCategoryErrors(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		fmt.Fprint(w, `{"httpStatus": 401}`)
+	}))
+	defer srv.Close()
+	c := NewClient(&Config{BaseURL: srv.URL})
+
+	_, err := c.CategoryID("Tools")
+	if apiErr, ok := err.(*Error); !ok || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Errorf("CategoryID() error = %v, want an *Error with status 401", err)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"net/http"
 	"net/url"
 	"path"
+	"strings"
 )
 
 const (
@@ -22,6 +23,12 @@ const (
 type Config struct {
 	BaseURL          string
 	Log              Logger
+	// ValidatePolicies makes CreatePolicy and UpdatePolicy validate the policy
+	// before sending it.
+	ValidatePolicies bool
+	// LintScripts makes CreateScript and UpdateScript refuse scripts with
+	// LintScript errors.
+	LintScripts bool
 	//baseURL          *url.URL
 	v1ApiToken       string
 	classicApiToken  string
@@ -105,7 +112,8 @@ func (c *Client) call(apiPath, method, apiVersion string,
 		body        io.Reader
 	)
 
-	if method != http.MethodDelete {
+	// DELETE requests only have a body when one is given
+	if method != http.MethodDelete || postBody != nil {
 		if apiVersion == "v1" {
 			contentType = "application/json"
 			jsonParams, err := json.Marshal(postBody)
@@ -142,11 +150,17 @@ func (c *Client) newRequest(
 	if err != nil {
 		return nil, err
 	}
+	// apiPath may hold escaped segments, see escapePathSegment
+	rawPath := u.EscapedPath()
 	if apiVersion == "v1" {
-		u.Path = path.Join(u.Path, APIPathV1, apiPath)
+		rawPath = path.Join(rawPath, APIPathV1, apiPath)
 	} else if apiVersion == "classic" {
-		u.Path = path.Join(u.Path, APIPathClassic, apiPath)
+		rawPath = path.Join(rawPath, APIPathClassic, apiPath)
 	}
+	if u.Path, err = url.PathUnescape(rawPath); err != nil {
+		return nil, err
+	}
+	u.RawPath = rawPath
 
 	u.RawQuery = queryParams.Encode()
 	// request with context
@@ -178,15 +192,69 @@ func (c *Client) do(req *http.Request, apiVersion string, res interface{}) error
 	}
 	defer response.Body.Close()
 
-	c.logf("[jamf-pro-go] %s: %v %v%v", response.Status, req.Method, req.URL.Host, req.URL.Path)
+	if err := c.checkResponse(req, response); err != nil {
+		return err
+	}
 
 	var r io.Reader = response.Body
 	// r = io.TeeReader(r, os.Stderr)
 
+	if apiVersion == "v1" {
+		return json.NewDecoder(r).Decode(&res)
+	} else if apiVersion == "classic" {
+		return xml.NewDecoder(r).Decode(&res)
+	}
+
+	return errors.New("[jamf-pro-go] apiVersion value is invalid")
+}
+
+// stream sends the request and returns the response with its body unread,
+// for responses that are not JSON or XML documents.
+// The caller must close the response body.
+func (c *Client) stream(apiPath, method, apiVersion, accept string,
+	queryParams url.Values, postBody interface{},
+) (*http.Response, error) {
+
+	var (
+		contentType string
+		body        io.Reader
+	)
+
+	if postBody != nil {
+		contentType = "application/json"
+		jsonParams, err := json.Marshal(postBody)
+		if err != nil {
+			return nil, err
+		}
+		body = bytes.NewBuffer(jsonParams)
+	}
+
+	req, err := c.newRequest(apiPath, method, contentType, apiVersion, queryParams, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", accept)
+
+	response, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if err := c.checkResponse(req, response); err != nil {
+		response.Body.Close()
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// checkResponse logs the response and returns an *Error for HTTP error statuses.
+func (c *Client) checkResponse(req *http.Request, response *http.Response) error {
+	c.logf("[jamf-pro-go] %s: %v %v%v", response.Status, req.Method, req.URL.Host, req.URL.Path)
+
 	// parse Jamf Pro (classic) API errors
 	code := response.StatusCode
 	if code >= http.StatusBadRequest {
-		byt, err := ioutil.ReadAll(r)
+		byt, err := ioutil.ReadAll(response.Body)
 		if err != nil {
 			// error occured, but ignored.
 			c.logf("[jamf-pro-go] HTTP response body: %v", err)
@@ -198,11 +266,15 @@ func (c *Client) do(req *http.Request, apiVersion string, res interface{}) error
 		return res
 	}
 
-	if apiVersion == "v1" {
-		return json.NewDecoder(r).Decode(&res)
-	} else if apiVersion == "classic" {
-		return xml.NewDecoder(r).Decode(&res)
-	}
+	return nil
+}
 
-	return errors.New("[jamf-pro-go] apiVersion value is invalid")
+// escapePathSegment escapes a value, e.g. a name, for use as a single
+// segment of an API path, so that "/" and ".." keep their literal meaning.
+func escapePathSegment(v string) string {
+	v = url.PathEscape(v)
+	if v == "." || v == ".." {
+		v = strings.ReplaceAll(v, ".", "%2E")
+	}
+	return v
 }
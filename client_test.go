@@ -0,0 +1,49 @@
+package jamf_pro_go
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetPolicyByNameEscapesName(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		want string
+	}{
+		{"Install Chrome", "/JSSResource/policies/name/Install%20Chrome"},
+		{"Office/Lab", "/JSSResource/policies/name/Office%2FLab"},
+		{"..", "/JSSResource/policies/name/%2E%2E"},
+		{"50% off?", "/JSSResource/policies/name/50%25%20off%3F"},
+	} {
+		var got string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			got = r.RequestURI
+			w.Write([]byte("<policy><general><id>1</id></general></policy>"))
+		}))
+		c := NewClient(&Config{BaseURL: srv.URL})
+		if _, err := c.GetPolicyByName(tt.name); err != nil {
+			t.Errorf("%q: %v", tt.name, err)
+		}
+		if got != tt.want {
+			t.Errorf("%q: requested %s, want %s", tt.name, got, tt.want)
+		}
+		srv.Close()
+	}
+}
+
+func TestRequestPathKeepsUnescapedSegments(t *testing.T) {
+	var got string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.RequestURI
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{BaseURL: srv.URL + "/jamf"})
+	if err := c.FlushPolicyLogs(7, LogFlushThreeMonths); err != nil {
+		t.Fatal(err)
+	}
+	if want := "/jamf/JSSResource/logflush/policy/id/7/interval/Three+Months"; got != want {
+		t.Errorf("requested %s, want %s", got, want)
+	}
+}
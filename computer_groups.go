@@ -0,0 +1,283 @@
+package jamf_pro_go
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net/http"
+	"path"
+)
+
+const (
+	APIVersionComputerGroups = "classic"
+	APIPathComputerGroups    = "computergroups"
+)
+
+// ComputerGroup is the classic API computer group object. A smart group
+// (IsSmart) holds Criteria and its members are computed by the server; a
+// static group lists its members in Computers.
+// It is used both for the result of GetComputerGroup and as the request body
+// of CreateComputerGroup and UpdateComputerGroup.
+type ComputerGroup struct {
+	XMLName   xml.Name                `xml:"computer_group"`
+	ID        uint32                  `xml:"id,omitempty"`
+	Name      string                  `xml:"name,omitempty"`
+	IsSmart   *bool                   `xml:"is_smart,omitempty"` // default: false
+	Site      *ComputerGroupSite      `xml:"site,omitempty"`
+	Criteria  *ComputerGroupCriteria  `xml:"criteria,omitempty"`
+	Computers *ComputerGroupComputers `xml:"computers,omitempty"`
+	// ComputerAdditions and ComputerDeletions change the members of a static
+	// group without sending all of them, see AddComputersToGroup.
+	ComputerAdditions *ComputerGroupMembers `xml:"computer_additions,omitempty"`
+	ComputerDeletions *ComputerGroupMembers `xml:"computer_deletions,omitempty"`
+	UnknownElements   []RawXMLElement       `xml:",any"`
+}
+
+type ComputerGroupSite struct {
+	ID              int32           `xml:"id,omitempty"` // default: -1
+	Name            string          `xml:"name,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
+}
+
+type ComputerGroupCriteria struct {
+	Size            uint32                    `xml:"size,omitempty"`
+	Criterion       []*ComputerGroupCriterion `xml:"criterion,omitempty"`
+	UnknownElements []RawXMLElement           `xml:",any"`
+}
+
+// ComputerGroupCriterion is one criterion of a smart group, e.g.
+// `and "Operating System Version" "greater than or equal" "12.0"`.
+// Criteria are evaluated in Priority order, AndOr joins a criterion to the
+// previous ones and OpeningParen and ClosingParen group them.
+type ComputerGroupCriterion struct {
+	Name            string          `xml:"name"`
+	Priority        int32           `xml:"priority"`
+	AndOr           CriterionAndOr  `xml:"and_or,omitempty"` // default: and
+	SearchType      SearchType      `xml:"search_type"`
+	Value           string          `xml:"value"`
+	OpeningParen    *bool           `xml:"opening_paren,omitempty"` // default: false
+	ClosingParen    *bool           `xml:"closing_paren,omitempty"` // default: false
+	UnknownElements []RawXMLElement `xml:",any"`
+}
+
+// SearchType is the operator of a smart group criterion. It is not validated,
+// as the available search types depend on the criterion.
+type SearchType string
+
+const (
+	SearchTypeIs                 SearchType = "is"
+	SearchTypeIsNot              SearchType = "is not"
+	SearchTypeLike               SearchType = "like"
+	SearchTypeNotLike            SearchType = "not like"
+	SearchTypeHas                SearchType = "has"
+	SearchTypeDoesNotHave        SearchType = "does not have"
+	SearchTypeMatchesRegex       SearchType = "matches regex"
+	SearchTypeDoesNotMatchRegex  SearchType = "does not match regex"
+	SearchTypeGreaterThan        SearchType = "greater than"
+	SearchTypeLessThan           SearchType = "less than"
+	SearchTypeGreaterThanOrEqual SearchType = "greater than or equal"
+	SearchTypeLessThanOrEqual    SearchType = "less than or equal"
+	SearchTypeMoreThanXDaysAgo   SearchType = "more than x days ago"
+	SearchTypeLessThanXDaysAgo   SearchType = "less than x days ago"
+	SearchTypeBefore             SearchType = "before (yyyy-mm-dd)"
+	SearchTypeAfter              SearchType = "after (yyyy-mm-dd)"
+	SearchTypeMemberOf           SearchType = "member of"
+	SearchTypeNotMemberOf        SearchType = "not member of"
+)
+
+type ComputerGroupComputers struct {
+	Size            uint32                   `xml:"size,omitempty"`
+	Computer        []*ComputerGroupComputer `xml:"computer,omitempty"`
+	UnknownElements []RawXMLElement          `xml:",any"`
+}
+
+// ComputerGroupMembers lists the computers added to or removed from a static
+// group.
+type ComputerGroupMembers struct {
+	Computer []*ComputerGroupComputer `xml:"computer,omitempty"`
+}
+
+type ComputerGroupComputer struct {
+	ID              uint32          `xml:"id,omitempty"`
+	Name            string          `xml:"name,omitempty"`
+	MacAddress      string          `xml:"mac_address,omitempty"`
+	AltMacAddress   string          `xml:"alt_mac_address,omitempty"`
+	SerialNumber    string          `xml:"serial_number,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
+}
+
+type GetComputerGroupsResult struct {
+	Size          uint32                  `xml:"size,omitempty"`
+	ComputerGroup []ComputerGroupOverview `xml:"computer_group,omitempty"`
+}
+
+type ComputerGroupOverview struct {
+	ID      uint32 `xml:"id,omitempty"`
+	Name    string `xml:"name,omitempty"`
+	IsSmart bool   `xml:"is_smart,omitempty"`
+}
+
+func (c *Client) GetComputerGroups() (*GetComputerGroupsResult, error) {
+	var result GetComputerGroupsResult
+
+	err := c.call(APIPathComputerGroups, http.MethodGet,
+		APIVersionComputerGroups, nil, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *Client) GetComputerGroup(groupID uint32) (*ComputerGroup, error) {
+	var result ComputerGroup
+
+	err := c.call(path.Join(APIPathComputerGroups, "id", fmt.Sprint(groupID)), http.MethodGet,
+		APIVersionComputerGroups, nil, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *Client) GetComputerGroupByName(name string) (*ComputerGroup, error) {
+	var result ComputerGroup
+
+	err := c.call(path.Join(APIPathComputerGroups, "name", escapePathSegment(name)), http.MethodGet,
+		APIVersionComputerGroups, nil, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+type ComputerGroupResult struct {
+	XMLName xml.Name `xml:"computer_group,omitempty"`
+	ID      uint32   `xml:"id,omitempty"`
+}
+
+func (c *Client) CreateComputerGroup(params *ComputerGroup) (*ComputerGroupResult, error) {
+	var result ComputerGroupResult
+
+	if err := validateEnums(params); err != nil {
+		return nil, err
+	}
+
+	err := c.call(path.Join(APIPathComputerGroups, "id", "0"), http.MethodPost,
+		APIVersionComputerGroups, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// UpdateComputerGroup updates the group. Fields left unset are not changed,
+// and setting Computers replaces all the members of a static group.
+func (c *Client) UpdateComputerGroup(groupID uint32, params *ComputerGroup) (*ComputerGroupResult, error) {
+	var result ComputerGroupResult
+
+	if err := validateEnums(params); err != nil {
+		return nil, err
+	}
+
+	err := c.call(path.Join(APIPathComputerGroups, "id", fmt.Sprint(groupID)), http.MethodPut,
+		APIVersionComputerGroups, nil, params, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+func (c *Client) DeleteComputerGroup(groupID uint32) error {
+	err := c.call(path.Join(APIPathComputerGroups, "id", fmt.Sprint(groupID)), http.MethodDelete,
+		APIVersionComputerGroups, nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	c.logf("[jamf-pro-go] Computer group (ID: %d) is deleted", groupID)
+
+	return nil
+}
+
+// AddComputersToGroup adds the computers to a static group, leaving its
+// other members as they are.
+func (c *Client) AddComputersToGroup(groupID uint32, computerIDs ...uint32) error {
+	if len(computerIDs) == 0 {
+		return errors.New("[Err] missing computer IDs")
+	}
+	_, err := c.UpdateComputerGroup(groupID, &ComputerGroup{ComputerAdditions: newComputerGroupMembers(computerIDs)})
+	return err
+}
+
+// RemoveComputersFromGroup removes the computers from a static group, leaving
+// its other members as they are.
+func (c *Client) RemoveComputersFromGroup(groupID uint32, computerIDs ...uint32) error {
+	if len(computerIDs) == 0 {
+		return errors.New("[Err] missing computer IDs")
+	}
+	_, err := c.UpdateComputerGroup(groupID, &ComputerGroup{ComputerDeletions: newComputerGroupMembers(computerIDs)})
+	return err
+}
+
+func newComputerGroupMembers(computerIDs []uint32) *ComputerGroupMembers {
+	m := &ComputerGroupMembers{}
+	for _, id := range computerIDs {
+		m.Computer = append(m.Computer, &ComputerGroupComputer{ID: id})
+	}
+	return m
+}
+
+// NewStaticComputerGroup returns a static group holding the computers.
+func NewStaticComputerGroup(name string, computerIDs ...uint32) *ComputerGroup {
+	g := &ComputerGroup{Name: name, IsSmart: Bool(false), Computers: &ComputerGroupComputers{}}
+	for _, id := range computerIDs {
+		g.Computers.Computer = append(g.Computers.Computer, &ComputerGroupComputer{ID: id})
+	}
+	return g
+}
+
+// NewSmartComputerGroup returns a smart group with copies of the criteria.
+// The priority of each copy is set to its index.
+func NewSmartComputerGroup(name string, criteria ...*ComputerGroupCriterion) *ComputerGroup {
+	copies := make([]*ComputerGroupCriterion, len(criteria))
+	for i, c := range criteria {
+		criterion := *c
+		criterion.Priority = int32(i)
+		copies[i] = &criterion
+	}
+	return &ComputerGroup{
+		Name:     name,
+		IsSmart:  Bool(true),
+		Criteria: &ComputerGroupCriteria{Criterion: copies},
+	}
+}
+
+// Classic list wrappers, see policy_lists.go
+
+func (l ComputerGroupCriteria) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalSizedList(e, start, l)
+}
+
+func (l *ComputerGroupCriteria) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalSizedList(d, start, l)
+}
+
+func (l ComputerGroupComputers) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalSizedList(e, start, l)
+}
+
+func (l *ComputerGroupComputers) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalSizedList(d, start, l)
+}
+
+func (l GetComputerGroupsResult) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalSizedList(e, start, l)
+}
+
+func (l *GetComputerGroupsResult) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalSizedList(d, start, l)
+}
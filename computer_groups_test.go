@@ -0,0 +1,123 @@
+package jamf_pro_go
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+// recordRequests serves response to every request and records the requests
+// as "METHOD path body".
+func recordRequests(t *testing.T, response string) (*Client, *[]string, func()) {
+	t.Helper()
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		requests = append(requests, r.Method+" "+r.URL.Path+" "+string(body))
+		w.Write([]byte(response))
+	}))
+	return NewClient(&Config{BaseURL: srv.URL}), &requests, srv.Close
+}
+
+func TestNewSmartComputerGroupCopiesCriteria(t *testing.T) {
+	a := &ComputerGroupCriterion{Name: "A", Priority: 5, SearchType: SearchTypeIs, Value: "1"}
+	b := &ComputerGroupCriterion{Name: "B", Priority: 5, AndOr: CriterionOr, SearchType: SearchTypeIs, Value: "2"}
+
+	g := NewSmartComputerGroup("g", a, b)
+	if a.Priority != 5 || b.Priority != 5 {
+		t.Errorf("criteria given were changed: priorities %d and %d", a.Priority, b.Priority)
+	}
+	got := g.Criteria.Criterion
+	if len(got) != 2 || got[0] == a || got[0].Priority != 0 || got[1].Priority != 1 || got[1].Name != "B" {
+		t.Errorf("criteria = %+v, want copies numbered 0 and 1", got)
+	}
+	if !BoolValue(g.IsSmart) {
+		t.Error("group is not smart")
+	}
+}
+
+func TestNewStaticComputerGroup(t *testing.T) {
+	c, requests, done := recordRequests(t, "<computer_group><id>4</id></computer_group>")
+	defer done()
+
+	result, err := c.CreateComputerGroup(NewStaticComputerGroup("Lab", 1, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.ID != 4 {
+		t.Errorf("ID = %d, want 4", result.ID)
+	}
+	want := "POST /JSSResource/computergroups/id/0 " +
+		"<computer_group><name>Lab</name><is_smart>false</is_smart>" +
+		"<computers><size>2</size><computer><id>1</id></computer><computer><id>2</id></computer></computers>" +
+		"</computer_group>"
+	if len(*requests) != 1 || (*requests)[0] != want {
+		t.Errorf("requests = %q, want %q", *requests, want)
+	}
+}
+
+func TestGroupMembership(t *testing.T) {
+	c, requests, done := recordRequests(t, "<computer_group><id>4</id></computer_group>")
+	defer done()
+
+	if err := c.AddComputersToGroup(4, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.RemoveComputersFromGroup(4, 3); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.AddComputersToGroup(4); err == nil {
+		t.Error("AddComputersToGroup() without IDs: no error")
+	}
+	if err := c.RemoveComputersFromGroup(4); err == nil {
+		t.Error("RemoveComputersFromGroup() without IDs: no error")
+	}
+
+	want := []string{
+		"PUT /JSSResource/computergroups/id/4 <computer_group>" +
+			"<computer_additions><computer><id>1</id></computer><computer><id>2</id></computer></computer_additions>" +
+			"</computer_group>",
+		"PUT /JSSResource/computergroups/id/4 <computer_group>" +
+			"<computer_deletions><computer><id>3</id></computer></computer_deletions>" +
+			"</computer_group>",
+	}
+	if len(*requests) != len(want) {
+		t.Fatalf("requests = %q, want %q", *requests, want)
+	}
+	for i := range want {
+		if (*requests)[i] != want[i] {
+			t.Errorf("request %d = %q, want %q", i, (*requests)[i], want[i])
+		}
+	}
+}
+
+func TestGetComputerGroup(t *testing.T) {
+	c, requests, done := recordRequests(t, `<computer_group><id>4</id><name>Old Macs</name><is_smart>true</is_smart>`+
+		`<criteria><size>1</size><criterion><name>Operating System Version</name><priority>0</priority>`+
+		`<and_or>AND</and_or><search_type>less than</search_type><value>12.0</value></criterion></criteria>`+
+		`</computer_group>`)
+	defer done()
+
+	g, err := c.GetComputerGroup(4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if (*requests)[0] != "GET /JSSResource/computergroups/id/4 " {
+		t.Errorf("request = %q", (*requests)[0])
+	}
+	if g.Name != "Old Macs" || !BoolValue(g.IsSmart) || g.Criteria.Size != 1 {
+		t.Fatalf("group = %+v", g)
+	}
+	if criterion := g.Criteria.Criterion[0]; criterion.AndOr != CriterionAnd || criterion.SearchType != SearchTypeLessThan {
+		t.Errorf("criterion = %+v", criterion)
+	}
+
+	m, err := g.Matcher()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := m.Match(CriteriaValues{"Operating System Version": {"11.7.1"}}); err != nil || !ok {
+		t.Errorf("Match() = %v, %v, want true", ok, err)
+	}
+}
@@ -0,0 +1,360 @@
+package jamf_pro_go
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+const (
+	APIVersionComputersInventory    = "v1"
+	APIPathComputersInventory       = "computers-inventory"
+	APIPathComputersInventoryDetail = "computers-inventory-detail"
+)
+
+// ComputerInventoryOpts selects the sections returned for each computer,
+// GENERAL only if none is given, along with the paging options. Sort and
+// Filter use the section fields, e.g. "general.name:asc" and
+// `general.name=="lab*"`.
+type ComputerInventoryOpts struct {
+	PageOpts
+	Section []InventorySection `url:"section,omitempty"`
+}
+
+type ComputersInventory struct {
+	TotalCount uint32              `json:"totalCount"`
+	Results    []ComputerInventory `json:"results"`
+}
+
+// ComputerInventory is the inventory of a computer. Sections that were not
+// requested are nil.
+type ComputerInventory struct {
+	ID                  string                       `json:"id"`
+	UDID                string                       `json:"udid"`
+	General             *ComputerGeneral             `json:"general"`
+	Hardware            *ComputerHardware            `json:"hardware"`
+	OperatingSystem     *ComputerOperatingSystem     `json:"operatingSystem"`
+	UserAndLocation     *ComputerUserAndLocation     `json:"userAndLocation"`
+	Applications        []ComputerApplication        `json:"applications"`
+	ExtensionAttributes []ComputerExtensionAttribute `json:"extensionAttributes"`
+	GroupMemberships    []ComputerGroupMembership    `json:"groupMemberships"`
+}
+
+type ComputerGeneral struct {
+	Name                                 string                       `json:"name"`
+	LastIPAddress                        string                       `json:"lastIpAddress"`
+	LastReportedIP                       string                       `json:"lastReportedIp"`
+	JamfBinaryVersion                    string                       `json:"jamfBinaryVersion"`
+	Platform                             string                       `json:"platform"`
+	Barcode1                             string                       `json:"barcode1"`
+	Barcode2                             string                       `json:"barcode2"`
+	AssetTag                             string                       `json:"assetTag"`
+	RemoteManagement                     *ComputerRemoteManagement    `json:"remoteManagement"`
+	Supervised                           bool                         `json:"supervised"`
+	ReportDate                           time.Time                    `json:"reportDate"`
+	LastContactTime                      time.Time                    `json:"lastContactTime"`
+	LastEnrolledDate                     time.Time                    `json:"lastEnrolledDate"`
+	MdmProfileExpiration                 time.Time                    `json:"mdmProfileExpiration"`
+	InitialEntryDate                     string                       `json:"initialEntryDate"` // yyyy-mm-dd
+	DistributionPoint                    string                       `json:"distributionPoint"`
+	Site                                 *ComputerSite                `json:"site"`
+	EnrolledViaAutomatedDeviceEnrollment bool                         `json:"enrolledViaAutomatedDeviceEnrollment"`
+	UserApprovedMdm                      bool                         `json:"userApprovedMdm"`
+	ManagementID                         string                       `json:"managementId"`
+	ExtensionAttributes                  []ComputerExtensionAttribute `json:"extensionAttributes"`
+}
+
+type ComputerRemoteManagement struct {
+	Managed            bool   `json:"managed"`
+	ManagementUsername string `json:"managementUsername"`
+}
+
+type ComputerSite struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type ComputerHardware struct {
+	Make                   string                       `json:"make"`
+	Model                  string                       `json:"model"`
+	ModelIdentifier        string                       `json:"modelIdentifier"`
+	SerialNumber           string                       `json:"serialNumber"`
+	ProcessorSpeedMhz      int64                        `json:"processorSpeedMhz"`
+	ProcessorCount         int32                        `json:"processorCount"`
+	CoreCount              int32                        `json:"coreCount"`
+	ProcessorType          string                       `json:"processorType"`
+	ProcessorArchitecture  string                       `json:"processorArchitecture"`
+	MacAddress             string                       `json:"macAddress"`
+	AltMacAddress          string                       `json:"altMacAddress"`
+	TotalRamMegabytes      int64                        `json:"totalRamMegabytes"`
+	BatteryCapacityPercent int32                        `json:"batteryCapacityPercent"`
+	BootRom                string                       `json:"bootRom"`
+	AppleSilicon           bool                         `json:"appleSilicon"`
+	ExtensionAttributes    []ComputerExtensionAttribute `json:"extensionAttributes"`
+}
+
+type ComputerOperatingSystem struct {
+	Name                     string                       `json:"name"`
+	Version                  string                       `json:"version"`
+	Build                    string                       `json:"build"`
+	SupplementalBuildVersion string                       `json:"supplementalBuildVersion"`
+	RapidSecurityResponse    string                       `json:"rapidSecurityResponse"`
+	ActiveDirectoryStatus    string                       `json:"activeDirectoryStatus"`
+	FileVault2Status         string                       `json:"fileVault2Status"`
+	ExtensionAttributes      []ComputerExtensionAttribute `json:"extensionAttributes"`
+}
+
+type ComputerUserAndLocation struct {
+	Username            string                       `json:"username"`
+	Realname            string                       `json:"realname"`
+	Email               string                       `json:"email"`
+	Position            string                       `json:"position"`
+	Phone               string                       `json:"phone"`
+	DepartmentID        string                       `json:"departmentId"`
+	BuildingID          string                       `json:"buildingId"`
+	Room                string                       `json:"room"`
+	ExtensionAttributes []ComputerExtensionAttribute `json:"extensionAttributes"`
+}
+
+type ComputerApplication struct {
+	Name              string `json:"name"`
+	Path              string `json:"path"`
+	Version           string `json:"version"`
+	MacAppStore       bool   `json:"macAppStore"`
+	SizeMegabytes     int64  `json:"sizeMegabytes"`
+	BundleID          string `json:"bundleId"`
+	UpdateAvailable   bool   `json:"updateAvailable"`
+	ExternalVersionID string `json:"externalVersionId"`
+}
+
+type ComputerExtensionAttribute struct {
+	DefinitionID string   `json:"definitionId"`
+	Name         string   `json:"name"`
+	Description  string   `json:"description"`
+	Enabled      bool     `json:"enabled"`
+	MultiValue   bool     `json:"multiValue"`
+	Values       []string `json:"values"`
+	DataType     string   `json:"dataType"` // [ STRING, INTEGER, DATE ]
+	Options      []string `json:"options"`
+	InputType    string   `json:"inputType"` // [ TEXT, POPUP, SCRIPT, LDAP ]
+}
+
+type ComputerGroupMembership struct {
+	GroupID    string `json:"groupId"`
+	GroupName  string `json:"groupName"`
+	SmartGroup bool   `json:"smartGroup"`
+}
+
+func (c *Client) GetComputersInventory(opts ComputerInventoryOpts) (*ComputersInventory, error) {
+	var result ComputersInventory
+
+	// the API only accepts the sections in upper case
+	sections := make([]InventorySection, len(opts.Section))
+	for i, s := range opts.Section {
+		section, err := normalizeEnum("inventory section", string(s), inventorySections)
+		if err != nil {
+			return nil, err
+		}
+		sections[i] = InventorySection(section)
+	}
+	opts.Section = sections
+
+	v, err := query.Values(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.call(path.Join(APIVersionComputersInventory, APIPathComputersInventory), http.MethodGet,
+		APIVersionComputersInventory, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+type ComputerInventoryPager struct {
+	*Pager
+	page *ComputersInventory
+}
+
+// Computers returns the computers of the current page.
+func (p *ComputerInventoryPager) Computers() []ComputerInventory {
+	if p.page == nil {
+		return nil
+	}
+	return p.page.Results
+}
+
+// PageComputersInventory returns a pager over the inventory of all computers
+// matching opts.
+func (c *Client) PageComputersInventory(opts ComputerInventoryOpts) *ComputerInventoryPager {
+	p := &ComputerInventoryPager{}
+	p.Pager = newPager(opts.PageOpts, func(page PageOpts) (int, uint32, error) {
+		o := opts
+		o.PageOpts = page
+		result, err := c.GetComputersInventory(o)
+		if err != nil {
+			return 0, 0, err
+		}
+		p.page = result
+		return len(result.Results), result.TotalCount, nil
+	})
+	return p
+}
+
+// GetComputerInventoryDetail returns the inventory of the computer with all
+// its sections.
+func (c *Client) GetComputerInventoryDetail(computerID uint32) (*ComputerInventory, error) {
+	var result ComputerInventory
+
+	err := c.call(path.Join(APIVersionComputersInventory, APIPathComputersInventoryDetail, fmt.Sprint(computerID)), http.MethodGet,
+		APIVersionComputersInventory, nil, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// computerCriteria maps smart group criteria to inventory fields. A field
+// whose section was not read is missing.
+var computerCriteria = map[string]func(ci *ComputerInventory) ([]string, bool){
+	"Computer Name":            generalValue(func(g *ComputerGeneral) string { return g.Name }),
+	"IP Address":               generalValue(func(g *ComputerGeneral) string { return g.LastIPAddress }),
+	"Reported IP Address":      generalValue(func(g *ComputerGeneral) string { return g.LastReportedIP }),
+	"Jamf Binary Version":      generalValue(func(g *ComputerGeneral) string { return g.JamfBinaryVersion }),
+	"Asset Tag":                generalValue(func(g *ComputerGeneral) string { return g.AssetTag }),
+	"Barcode 1":                generalValue(func(g *ComputerGeneral) string { return g.Barcode1 }),
+	"Barcode 2":                generalValue(func(g *ComputerGeneral) string { return g.Barcode2 }),
+	"Last Check-in":            generalValue(func(g *ComputerGeneral) string { return formatInventoryTime(g.LastContactTime) }),
+	"Last Inventory Update":    generalValue(func(g *ComputerGeneral) string { return formatInventoryTime(g.ReportDate) }),
+	"Last Enrollment":          generalValue(func(g *ComputerGeneral) string { return formatInventoryTime(g.LastEnrolledDate) }),
+	"Serial Number":            hardwareValue(func(h *ComputerHardware) string { return h.SerialNumber }),
+	"Model":                    hardwareValue(func(h *ComputerHardware) string { return h.Model }),
+	"Model Identifier":         hardwareValue(func(h *ComputerHardware) string { return h.ModelIdentifier }),
+	"Processor Type":           hardwareValue(func(h *ComputerHardware) string { return h.ProcessorType }),
+	"Architecture Type":        hardwareValue(func(h *ComputerHardware) string { return h.ProcessorArchitecture }),
+	"MAC Address":              hardwareValue(func(h *ComputerHardware) string { return h.MacAddress }),
+	"Total RAM MB":             hardwareValue(func(h *ComputerHardware) string { return fmt.Sprint(h.TotalRamMegabytes) }),
+	"Operating System Version": operatingSystemValue(func(o *ComputerOperatingSystem) string { return o.Version }),
+	"Operating System Build":   operatingSystemValue(func(o *ComputerOperatingSystem) string { return o.Build }),
+	"Operating System Name":    operatingSystemValue(func(o *ComputerOperatingSystem) string { return o.Name }),
+	"FileVault 2 Status":       operatingSystemValue(func(o *ComputerOperatingSystem) string { return o.FileVault2Status }),
+	"Username":                 userAndLocationValue(func(u *ComputerUserAndLocation) string { return u.Username }),
+	"Full Name":                userAndLocationValue(func(u *ComputerUserAndLocation) string { return u.Realname }),
+	"Email Address":            userAndLocationValue(func(u *ComputerUserAndLocation) string { return u.Email }),
+	"Position":                 userAndLocationValue(func(u *ComputerUserAndLocation) string { return u.Position }),
+	"Room":                     userAndLocationValue(func(u *ComputerUserAndLocation) string { return u.Room }),
+	"Application Title": func(ci *ComputerInventory) ([]string, bool) {
+		if ci.Applications == nil {
+			return nil, false
+		}
+		values := make([]string, 0, len(ci.Applications))
+		for _, a := range ci.Applications {
+			values = append(values, a.Name)
+		}
+		return values, true
+	},
+	"Application Bundle ID": func(ci *ComputerInventory) ([]string, bool) {
+		if ci.Applications == nil {
+			return nil, false
+		}
+		values := make([]string, 0, len(ci.Applications))
+		for _, a := range ci.Applications {
+			values = append(values, a.BundleID)
+		}
+		return values, true
+	},
+	"Computer Group": func(ci *ComputerInventory) ([]string, bool) {
+		if ci.GroupMemberships == nil {
+			return nil, false
+		}
+		values := make([]string, 0, len(ci.GroupMemberships))
+		for _, m := range ci.GroupMemberships {
+			values = append(values, m.GroupName)
+		}
+		return values, true
+	},
+}
+
+// CriterionValues makes the inventory a CriteriaRecord, so smart group
+// criteria can be evaluated against it. Besides the common criteria, any
+// extension attribute can be used by its name.
+func (ci *ComputerInventory) CriterionValues(name string) ([]string, bool) {
+	if f, ok := computerCriteria[name]; ok {
+		return f(ci)
+	}
+	for _, ea := range ci.allExtensionAttributes() {
+		if strings.EqualFold(ea.Name, name) {
+			return ea.Values, true
+		}
+	}
+	return nil, false
+}
+
+// allExtensionAttributes returns the extension attributes of every section
+// that was read.
+func (ci *ComputerInventory) allExtensionAttributes() []ComputerExtensionAttribute {
+	eas := append([]ComputerExtensionAttribute(nil), ci.ExtensionAttributes...)
+	if ci.General != nil {
+		eas = append(eas, ci.General.ExtensionAttributes...)
+	}
+	if ci.Hardware != nil {
+		eas = append(eas, ci.Hardware.ExtensionAttributes...)
+	}
+	if ci.OperatingSystem != nil {
+		eas = append(eas, ci.OperatingSystem.ExtensionAttributes...)
+	}
+	if ci.UserAndLocation != nil {
+		eas = append(eas, ci.UserAndLocation.ExtensionAttributes...)
+	}
+	return eas
+}
+
+func generalValue(f func(*ComputerGeneral) string) func(*ComputerInventory) ([]string, bool) {
+	return func(ci *ComputerInventory) ([]string, bool) {
+		if ci.General == nil {
+			return nil, false
+		}
+		return []string{f(ci.General)}, true
+	}
+}
+
+func hardwareValue(f func(*ComputerHardware) string) func(*ComputerInventory) ([]string, bool) {
+	return func(ci *ComputerInventory) ([]string, bool) {
+		if ci.Hardware == nil {
+			return nil, false
+		}
+		return []string{f(ci.Hardware)}, true
+	}
+}
+
+func operatingSystemValue(f func(*ComputerOperatingSystem) string) func(*ComputerInventory) ([]string, bool) {
+	return func(ci *ComputerInventory) ([]string, bool) {
+		if ci.OperatingSystem == nil {
+			return nil, false
+		}
+		return []string{f(ci.OperatingSystem)}, true
+	}
+}
+
+func userAndLocationValue(f func(*ComputerUserAndLocation) string) func(*ComputerInventory) ([]string, bool) {
+	return func(ci *ComputerInventory) ([]string, bool) {
+		if ci.UserAndLocation == nil {
+			return nil, false
+		}
+		return []string{f(ci.UserAndLocation)}, true
+	}
+}
+
+func formatInventoryTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
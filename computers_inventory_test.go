@@ -0,0 +1,43 @@
+package jamf_pro_go
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestGetComputersInventoryQuery(t *testing.T) {
+	var got []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/uapi/v1/computers-inventory" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		got = r.URL.Query()["section"]
+		fmt.Fprint(w, `{"totalCount": 0, "results": []}`)
+	}))
+	defer srv.Close()
+	c := NewClient(&Config{BaseURL: srv.URL})
+
+	_, err := c.GetComputersInventory(ComputerInventoryOpts{
+		Section: []InventorySection{"general", InventorySectionHardware, "Disk_Encryption"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"GENERAL", "HARDWARE", "DISK_ENCRYPTION"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("section = %v, want %v", got, want)
+	}
+
+	got = nil
+	_, err = c.GetComputersInventory(ComputerInventoryOpts{Section: []InventorySection{"GENERAL", "NETWORK"}})
+	var invalid *InvalidValueError
+	if !errors.As(err, &invalid) || invalid.Value != "NETWORK" {
+		t.Errorf("error = %v, want an *InvalidValueError for NETWORK", err)
+	}
+	if got != nil {
+		t.Errorf("request sent with section = %v", got)
+	}
+}
@@ -0,0 +1,472 @@
+package jamf_pro_go
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// CriteriaBuilder builds the criteria of a smart group, numbering their
+// priorities and placing the parentheses.
+//
+//	criteria, err := jamf.NewCriteria().
+//		Where("Operating System Version", jamf.SearchTypeLessThan, "12.0").
+//		AndGroup(func(g *jamf.CriteriaBuilder) {
+//			g.Where("Computer Name", jamf.SearchTypeLike, "lab").
+//				Or("Computer Name", jamf.SearchTypeLike, "kiosk")
+//		}).
+//		Build()
+//
+// is `OS < 12.0 and (name like lab or name like kiosk)`.
+type CriteriaBuilder struct {
+	criteria []*ComputerGroupCriterion
+	depth    int
+	errs     []ValidationProblem
+}
+
+func NewCriteria() *CriteriaBuilder {
+	return &CriteriaBuilder{}
+}
+
+// Where adds the first criterion. It is the same as And.
+func (b *CriteriaBuilder) Where(name string, searchType SearchType, value string) *CriteriaBuilder {
+	return b.add(CriterionAnd, name, searchType, value)
+}
+
+func (b *CriteriaBuilder) And(name string, searchType SearchType, value string) *CriteriaBuilder {
+	return b.add(CriterionAnd, name, searchType, value)
+}
+
+func (b *CriteriaBuilder) Or(name string, searchType SearchType, value string) *CriteriaBuilder {
+	return b.add(CriterionOr, name, searchType, value)
+}
+
+// AndGroup adds the criteria added by group, in parentheses, joined with and.
+func (b *CriteriaBuilder) AndGroup(group func(g *CriteriaBuilder)) *CriteriaBuilder {
+	return b.group(CriterionAnd, group)
+}
+
+// OrGroup adds the criteria added by group, in parentheses, joined with or.
+func (b *CriteriaBuilder) OrGroup(group func(g *CriteriaBuilder)) *CriteriaBuilder {
+	return b.group(CriterionOr, group)
+}
+
+// Build returns the criteria. Jamf Pro has a single level of parentheses, so
+// a group inside a group is an error.
+func (b *CriteriaBuilder) Build() ([]*ComputerGroupCriterion, error) {
+	v := &ValidationError{Problems: append([]ValidationProblem(nil), b.errs...)}
+	if len(b.criteria) > 0 {
+		b.criteria[0].AndOr = CriterionAnd
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	return b.criteria, nil
+}
+
+func (b *CriteriaBuilder) add(andOr CriterionAndOr, name string, searchType SearchType, value string) *CriteriaBuilder {
+	b.criteria = append(b.criteria, &ComputerGroupCriterion{
+		Name:       name,
+		Priority:   int32(len(b.criteria)),
+		AndOr:      andOr,
+		SearchType: searchType,
+		Value:      value,
+	})
+	return b
+}
+
+func (b *CriteriaBuilder) group(andOr CriterionAndOr, group func(g *CriteriaBuilder)) *CriteriaBuilder {
+	field := fmt.Sprintf("criteria[%d]", len(b.criteria))
+	if b.depth > 0 {
+		b.fail(field, "groups cannot be nested")
+		return b
+	}
+
+	start := len(b.criteria)
+	b.depth++
+	group(b)
+	b.depth--
+
+	if len(b.criteria) == start {
+		b.fail(field, "group has no criteria")
+		return b
+	}
+	first, last := b.criteria[start], b.criteria[len(b.criteria)-1]
+	first.AndOr = andOr
+	first.OpeningParen = Bool(true)
+	last.ClosingParen = Bool(true)
+	return b
+}
+
+func (b *CriteriaBuilder) fail(field, format string, a ...interface{}) {
+	b.errs = append(b.errs, ValidationProblem{Field: field, Message: fmt.Sprintf(format, a...)})
+}
+
+// CriteriaRecord is an inventory record smart group criteria can be
+// evaluated against.
+type CriteriaRecord interface {
+	// CriterionValues returns the values of the field named like a criterion,
+	// e.g. "Operating System Version", and false if the record does not have
+	// such a field. Fields such as "Application Title" have several values.
+	CriterionValues(name string) ([]string, bool)
+}
+
+// CriteriaValues is a CriteriaRecord made of values keyed by criterion name.
+type CriteriaValues map[string][]string
+
+func (v CriteriaValues) CriterionValues(name string) ([]string, bool) {
+	values, ok := v[name]
+	return values, ok
+}
+
+// CriteriaMatcher evaluates smart group criteria the way Jamf Pro does: in
+// priority order, with and binding tighter than or, and parentheses grouping
+// criteria.
+type CriteriaMatcher struct {
+	root *criteriaNode
+	now  func() time.Time
+}
+
+type criteriaNode struct {
+	andOr       CriterionAndOr // of the node, empty for a criterion
+	left, right *criteriaNode
+	criterion   *ComputerGroupCriterion
+	regexp      *regexp.Regexp
+}
+
+// CompileCriteria checks the criteria and returns their matcher. No criteria
+// match every record, as in a smart group without criteria.
+func CompileCriteria(criteria []*ComputerGroupCriterion) (*CriteriaMatcher, error) {
+	for i, c := range criteria {
+		if c == nil {
+			return nil, fmt.Errorf("[jamf-pro-go] criteria: criteria[%d] is nil", i)
+		}
+	}
+	sorted := append([]*ComputerGroupCriterion(nil), criteria...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Priority < sorted[j].Priority
+	})
+
+	p := &criteriaParser{}
+	for i, c := range sorted {
+		if i > 0 {
+			andOr, err := normalizeEnum("criterion and_or", string(c.AndOr), criterionAndOrs)
+			if err != nil {
+				return nil, err
+			}
+			if andOr == string(CriterionOr) {
+				p.tokens = append(p.tokens, criteriaToken{kind: '|'})
+			} else {
+				p.tokens = append(p.tokens, criteriaToken{kind: '&'})
+			}
+		}
+		if BoolValue(c.OpeningParen) {
+			p.tokens = append(p.tokens, criteriaToken{kind: '('})
+		}
+		p.tokens = append(p.tokens, criteriaToken{kind: 'c', criterion: c})
+		if BoolValue(c.ClosingParen) {
+			p.tokens = append(p.tokens, criteriaToken{kind: ')'})
+		}
+	}
+
+	m := &CriteriaMatcher{now: time.Now}
+	if len(p.tokens) == 0 {
+		return m, nil
+	}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if p.pos < len(p.tokens) {
+		return nil, fmt.Errorf("[jamf-pro-go] criteria: unbalanced closing parenthesis")
+	}
+	m.root = root
+	return m, nil
+}
+
+// Matcher compiles the criteria of a smart group.
+func (g *ComputerGroup) Matcher() (*CriteriaMatcher, error) {
+	if g.Criteria == nil {
+		return CompileCriteria(nil)
+	}
+	return CompileCriteria(g.Criteria.Criterion)
+}
+
+// Match reports whether the record matches the criteria.
+func (m *CriteriaMatcher) Match(record CriteriaRecord) (bool, error) {
+	if m.root == nil {
+		return true, nil
+	}
+	return m.eval(m.root, record, m.now())
+}
+
+// Filter returns the records matching the criteria, e.g. to preview the
+// members of a smart group before saving it.
+func (m *CriteriaMatcher) Filter(records []CriteriaRecord) ([]CriteriaRecord, error) {
+	var matched []CriteriaRecord
+	for _, r := range records {
+		ok, err := m.Match(r)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			matched = append(matched, r)
+		}
+	}
+	return matched, nil
+}
+
+func (m *CriteriaMatcher) eval(n *criteriaNode, record CriteriaRecord, now time.Time) (bool, error) {
+	switch n.andOr {
+	case CriterionAnd, CriterionOr:
+		left, err := m.eval(n.left, record, now)
+		if err != nil {
+			return false, err
+		}
+		// no short-circuit, so that a record missing a field always fails
+		right, err := m.eval(n.right, record, now)
+		if err != nil {
+			return false, err
+		}
+		if n.andOr == CriterionAnd {
+			return left && right, nil
+		}
+		return left || right, nil
+	}
+
+	c := n.criterion
+	values, ok := record.CriterionValues(c.Name)
+	if !ok {
+		return false, fmt.Errorf("[jamf-pro-go] criteria: record has no field %q", c.Name)
+	}
+	if len(values) == 0 {
+		values = []string{""}
+	}
+
+	switch strings.ToLower(string(c.SearchType)) {
+	case string(SearchTypeIs), string(SearchTypeHas), string(SearchTypeMemberOf):
+		return anyValue(values, func(v string) bool { return strings.EqualFold(v, c.Value) }), nil
+	case string(SearchTypeIsNot), string(SearchTypeDoesNotHave), string(SearchTypeNotMemberOf):
+		return !anyValue(values, func(v string) bool { return strings.EqualFold(v, c.Value) }), nil
+	case string(SearchTypeLike):
+		return anyValue(values, func(v string) bool { return containsFold(v, c.Value) }), nil
+	case string(SearchTypeNotLike):
+		return !anyValue(values, func(v string) bool { return containsFold(v, c.Value) }), nil
+	case string(SearchTypeMatchesRegex):
+		return anyValue(values, n.regexp.MatchString), nil
+	case string(SearchTypeDoesNotMatchRegex):
+		return !anyValue(values, n.regexp.MatchString), nil
+	case string(SearchTypeGreaterThan), string(SearchTypeLessThan),
+		string(SearchTypeGreaterThanOrEqual), string(SearchTypeLessThanOrEqual):
+		return compareCriterion(c, values)
+	case string(SearchTypeMoreThanXDaysAgo), string(SearchTypeLessThanXDaysAgo):
+		days, err := strconv.Atoi(strings.TrimSpace(c.Value))
+		if err != nil {
+			return false, fmt.Errorf("[jamf-pro-go] criteria: %q: invalid number of days %q", c.Name, c.Value)
+		}
+		limit := now.AddDate(0, 0, -days)
+		return anyValue(values, func(v string) bool {
+			t, ok := parseCriterionDate(v)
+			if !ok {
+				return false
+			}
+			if strings.EqualFold(string(c.SearchType), string(SearchTypeMoreThanXDaysAgo)) {
+				return t.Before(limit)
+			}
+			return t.After(limit)
+		}), nil
+	case string(SearchTypeBefore), string(SearchTypeAfter):
+		limit, ok := parseCriterionDate(c.Value)
+		if !ok {
+			return false, fmt.Errorf("[jamf-pro-go] criteria: %q: invalid date %q, expected yyyy-mm-dd", c.Name, c.Value)
+		}
+		return anyValue(values, func(v string) bool {
+			t, ok := parseCriterionDate(v)
+			if !ok {
+				return false
+			}
+			if strings.EqualFold(string(c.SearchType), string(SearchTypeBefore)) {
+				return t.Before(limit)
+			}
+			return t.After(limit)
+		}), nil
+	}
+	return false, fmt.Errorf("[jamf-pro-go] criteria: %q: unsupported search type %q", c.Name, c.SearchType)
+}
+
+type criteriaToken struct {
+	kind      byte // '(', ')', '&', '|' or 'c' for a criterion
+	criterion *ComputerGroupCriterion
+}
+
+type criteriaParser struct {
+	tokens []criteriaToken
+	pos    int
+}
+
+func (p *criteriaParser) peek() byte {
+	if p.pos < len(p.tokens) {
+		return p.tokens[p.pos].kind
+	}
+	return 0
+}
+
+// parseOr parses `and-expression (or and-expression)*`.
+func (p *criteriaParser) parseOr() (*criteriaNode, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == '|' {
+		p.pos++
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		left = &criteriaNode{andOr: CriterionOr, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parseAnd parses `primary (and primary)*`.
+func (p *criteriaParser) parseAnd() (*criteriaNode, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+	for p.peek() == '&' {
+		p.pos++
+		right, err := p.parsePrimary()
+		if err != nil {
+			return nil, err
+		}
+		left = &criteriaNode{andOr: CriterionAnd, left: left, right: right}
+	}
+	return left, nil
+}
+
+// parsePrimary parses a criterion or `( or-expression )`.
+func (p *criteriaParser) parsePrimary() (*criteriaNode, error) {
+	switch p.peek() {
+	case '(':
+		p.pos++
+		n, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, fmt.Errorf("[jamf-pro-go] criteria: unbalanced opening parenthesis")
+		}
+		p.pos++
+		return n, nil
+	case 'c':
+		c := p.tokens[p.pos].criterion
+		p.pos++
+		n := &criteriaNode{criterion: c}
+		switch strings.ToLower(string(c.SearchType)) {
+		case string(SearchTypeMatchesRegex), string(SearchTypeDoesNotMatchRegex):
+			re, err := regexp.Compile(c.Value)
+			if err != nil {
+				return nil, fmt.Errorf("[jamf-pro-go] criteria: %q: %w", c.Name, err)
+			}
+			n.regexp = re
+		}
+		return n, nil
+	}
+	return nil, fmt.Errorf("[jamf-pro-go] criteria: unbalanced closing parenthesis")
+}
+
+func anyValue(values []string, match func(string) bool) bool {
+	for _, v := range values {
+		if match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+var versionPattern = regexp.MustCompile(`^[0-9]+(\.[0-9]+)*$`)
+
+// compareCriterion compares versions such as 12.6.1 part by part, and other
+// values as numbers. Values that are neither never match.
+func compareCriterion(c *ComputerGroupCriterion, values []string) (bool, error) {
+	want := strings.TrimSpace(c.Value)
+	wantNumber, numberErr := strconv.ParseFloat(want, 64)
+	if !versionPattern.MatchString(want) && numberErr != nil {
+		return false, fmt.Errorf("[jamf-pro-go] criteria: %q: %q is not a number or a version", c.Name, c.Value)
+	}
+
+	return anyValue(values, func(v string) bool {
+		v = strings.TrimSpace(v)
+		var cmp int
+		switch {
+		case versionPattern.MatchString(v) && versionPattern.MatchString(want):
+			cmp = compareVersions(v, want)
+		default:
+			n, err := strconv.ParseFloat(v, 64)
+			if err != nil || numberErr != nil {
+				return false
+			}
+			switch {
+			case n < wantNumber:
+				cmp = -1
+			case n > wantNumber:
+				cmp = 1
+			}
+		}
+		switch strings.ToLower(string(c.SearchType)) {
+		case string(SearchTypeGreaterThan):
+			return cmp > 0
+		case string(SearchTypeLessThan):
+			return cmp < 0
+		case string(SearchTypeGreaterThanOrEqual):
+			return cmp >= 0
+		}
+		return cmp <= 0
+	}), nil
+}
+
+// compareVersions compares two dotted versions, missing parts counting as 0.
+func compareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
+
+var criterionDateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+func parseCriterionDate(v string) (time.Time, bool) {
+	v = strings.TrimSpace(v)
+	for _, layout := range criterionDateLayouts {
+		if t, err := time.Parse(layout, v); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
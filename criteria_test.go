@@ -0,0 +1,209 @@
+package jamf_pro_go
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// criterion is a shorthand for the criteria of the tests, in priority order.
+func criterion(andOr CriterionAndOr, name string, searchType SearchType, value string) *ComputerGroupCriterion {
+	return &ComputerGroupCriterion{Name: name, AndOr: andOr, SearchType: searchType, Value: value}
+}
+
+func withParens(c *ComputerGroupCriterion, opening, closing bool) *ComputerGroupCriterion {
+	if opening {
+		c.OpeningParen = Bool(true)
+	}
+	if closing {
+		c.ClosingParen = Bool(true)
+	}
+	return c
+}
+
+func numbered(criteria ...*ComputerGroupCriterion) []*ComputerGroupCriterion {
+	for i, c := range criteria {
+		c.Priority = int32(i)
+	}
+	return criteria
+}
+
+func TestCriteriaMatch(t *testing.T) {
+	a := func(andOr CriterionAndOr) *ComputerGroupCriterion { return criterion(andOr, "A", SearchTypeIs, "yes") }
+	b := func(andOr CriterionAndOr) *ComputerGroupCriterion { return criterion(andOr, "B", SearchTypeIs, "yes") }
+	c := func(andOr CriterionAndOr) *ComputerGroupCriterion { return criterion(andOr, "C", SearchTypeIs, "yes") }
+	record := func(a, b, c string) CriteriaValues {
+		return CriteriaValues{"A": {a}, "B": {b}, "C": {c}}
+	}
+
+	for _, tt := range []struct {
+		name     string
+		criteria []*ComputerGroupCriterion
+		record   CriteriaValues
+		want     bool
+	}{
+		{"no criteria", nil, record("no", "no", "no"), true},
+		// a or (b and c)
+		{"a or b and c: a", numbered(a(CriterionAnd), b(CriterionOr), c(CriterionAnd)), record("yes", "no", "no"), true},
+		{"a or b and c: b and c", numbered(a(CriterionAnd), b(CriterionOr), c(CriterionAnd)), record("no", "yes", "yes"), true},
+		{"a or b and c: c", numbered(a(CriterionAnd), b(CriterionOr), c(CriterionAnd)), record("no", "no", "yes"), false},
+		// (a or b) and c
+		{"(a or b) and c: a", numbered(withParens(a(CriterionAnd), true, false), withParens(b(CriterionOr), false, true), c(CriterionAnd)),
+			record("yes", "no", "no"), false},
+		{"(a or b) and c: a and c", numbered(withParens(a(CriterionAnd), true, false), withParens(b(CriterionOr), false, true), c(CriterionAnd)),
+			record("yes", "no", "yes"), true},
+		// a and (b or c)
+		{"a and (b or c)", numbered(a(CriterionAnd), withParens(b(CriterionAnd), true, false), withParens(c(CriterionOr), false, true)),
+			record("yes", "no", "yes"), true},
+		{"priority order", []*ComputerGroupCriterion{
+			{Name: "C", Priority: 2, AndOr: CriterionAnd, SearchType: SearchTypeIs, Value: "yes"},
+			{Name: "A", Priority: 0, SearchType: SearchTypeIs, Value: "yes"},
+			{Name: "B", Priority: 1, AndOr: CriterionOr, SearchType: SearchTypeIs, Value: "yes"},
+		}, record("no", "no", "yes"), false},
+		{"like", numbered(criterion(CriterionAnd, "A", SearchTypeLike, "ES")), record("yes", "", ""), true},
+		{"not like", numbered(criterion(CriterionAnd, "A", SearchTypeNotLike, "es")), record("yes", "", ""), false},
+		{"matches regex", numbered(criterion(CriterionAnd, "A", SearchTypeMatchesRegex, "^y.s$")), record("yes", "", ""), true},
+		{"version greater than", numbered(criterion(CriterionAnd, "A", SearchTypeGreaterThan, "12.9")), record("12.10", "", ""), true},
+		{"version less than", numbered(criterion(CriterionAnd, "A", SearchTypeLessThan, "12.9")), record("12.10", "", ""), false},
+		{"version missing part", numbered(criterion(CriterionAnd, "A", SearchTypeGreaterThanOrEqual, "12.0.0")), record("12", "", ""), true},
+		{"number", numbered(criterion(CriterionAnd, "A", SearchTypeGreaterThan, "999")), record("1.5e3", "", ""), true},
+		{"not a number", numbered(criterion(CriterionAnd, "A", SearchTypeGreaterThan, "1")), record("n/a", "", ""), false},
+	} {
+		m, err := CompileCriteria(tt.criteria)
+		if err != nil {
+			t.Errorf("%s: CompileCriteria: %v", tt.name, err)
+			continue
+		}
+		got, err := m.Match(tt.record)
+		if err != nil {
+			t.Errorf("%s: Match: %v", tt.name, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s: Match() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestCriteriaDays(t *testing.T) {
+	now := time.Date(2024, 3, 15, 12, 0, 0, 0, time.UTC)
+
+	for _, tt := range []struct {
+		searchType SearchType
+		value      string
+		date       string
+		want       bool
+	}{
+		{SearchTypeMoreThanXDaysAgo, "30", "2024-01-01", true},
+		{SearchTypeMoreThanXDaysAgo, "30", "2024-03-10T08:00:00Z", false},
+		{SearchTypeLessThanXDaysAgo, "30", "2024-03-10 08:00:00", true},
+		{SearchTypeLessThanXDaysAgo, "30", "2024-01-01", false},
+		{SearchTypeLessThanXDaysAgo, "30", "", false},
+		{SearchTypeBefore, "2024-03-01", "2024-02-29", true},
+		{SearchTypeAfter, "2024-03-01", "2024-02-29", false},
+	} {
+		m, err := CompileCriteria(numbered(criterion(CriterionAnd, "Last Check-in", tt.searchType, tt.value)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		m.now = func() time.Time { return now }
+		got, err := m.Match(CriteriaValues{"Last Check-in": {tt.date}})
+		if err != nil {
+			t.Errorf("%s %s %q: %v", tt.searchType, tt.value, tt.date, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%s %s %q: Match() = %v, want %v", tt.searchType, tt.value, tt.date, got, tt.want)
+		}
+	}
+}
+
+func TestCompileCriteriaErrors(t *testing.T) {
+	a := func() *ComputerGroupCriterion { return criterion(CriterionAnd, "A", SearchTypeIs, "yes") }
+
+	for _, tt := range []struct {
+		name     string
+		criteria []*ComputerGroupCriterion
+		want     string
+	}{
+		{"nil criterion", []*ComputerGroupCriterion{a(), nil}, "criteria[1] is nil"},
+		{"unbalanced opening", numbered(withParens(a(), true, false), a()), "unbalanced opening parenthesis"},
+		{"unbalanced closing", numbered(a(), withParens(a(), false, true)), "unbalanced closing parenthesis"},
+		{"empty group", numbered(withParens(a(), false, true)), "unbalanced closing parenthesis"},
+		{"invalid and_or", numbered(a(), criterion("xor", "A", SearchTypeIs, "yes")), "and_or"},
+		{"invalid regex", numbered(criterion(CriterionAnd, "A", SearchTypeMatchesRegex, "(")), "A"},
+	} {
+		_, err := CompileCriteria(tt.criteria)
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: CompileCriteria() error = %v, want %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestCriteriaBuilder(t *testing.T) {
+	criteria, err := NewCriteria().
+		Where("A", SearchTypeIs, "yes").
+		OrGroup(func(g *CriteriaBuilder) {
+			g.Where("B", SearchTypeIs, "yes").
+				And("C", SearchTypeIs, "yes")
+		}).
+		Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []struct {
+		andOr            CriterionAndOr
+		opening, closing bool
+	}{
+		{CriterionAnd, false, false},
+		{CriterionOr, true, false},
+		{CriterionAnd, false, true},
+	}
+	if len(criteria) != len(want) {
+		t.Fatalf("got %d criteria, want %d", len(criteria), len(want))
+	}
+	for i, w := range want {
+		c := criteria[i]
+		if c.Priority != int32(i) || c.AndOr != w.andOr || BoolValue(c.OpeningParen) != w.opening || BoolValue(c.ClosingParen) != w.closing {
+			t.Errorf("criteria[%d] = %d %s %v %v, want %d %s %v %v", i,
+				c.Priority, c.AndOr, BoolValue(c.OpeningParen), BoolValue(c.ClosingParen),
+				i, w.andOr, w.opening, w.closing)
+		}
+	}
+
+	m, err := CompileCriteria(criteria)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ok, err := m.Match(CriteriaValues{"A": {"no"}, "B": {"yes"}, "C": {"yes"}})
+	if err != nil || !ok {
+		t.Errorf("Match() = %v, %v, want true", ok, err)
+	}
+}
+
+func TestCriteriaBuilderErrors(t *testing.T) {
+	for _, tt := range []struct {
+		name  string
+		build func(b *CriteriaBuilder)
+		want  string
+	}{
+		{"nested group", func(b *CriteriaBuilder) {
+			b.Where("A", SearchTypeIs, "yes").AndGroup(func(g *CriteriaBuilder) {
+				g.Where("B", SearchTypeIs, "yes").OrGroup(func(g *CriteriaBuilder) {
+					g.Where("C", SearchTypeIs, "yes")
+				})
+			})
+		}, "groups cannot be nested"},
+		{"empty group", func(b *CriteriaBuilder) {
+			b.Where("A", SearchTypeIs, "yes").AndGroup(func(g *CriteriaBuilder) {})
+		}, "group has no criteria"},
+	} {
+		b := NewCriteria()
+		tt.build(b)
+		_, err := b.Build()
+		if _, ok := err.(*ValidationError); !ok || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: Build() error = %v, want a *ValidationError with %q", tt.name, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package jamf_pro_go
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type DependencyKind string
+
+const (
+	DependencyPolicy   DependencyKind = "policy"
+	DependencyScript   DependencyKind = "script"
+	DependencyPackage  DependencyKind = "package"
+	DependencyPrinter  DependencyKind = "printer"
+	DependencyDockItem DependencyKind = "dock_item"
+	DependencyCategory DependencyKind = "category"
+
+	// scope targets, reported by SafeDeletePolicy
+	DependencyComputer      DependencyKind = "computer"
+	DependencyComputerGroup DependencyKind = "computer_group"
+)
+
+// DependencyRef identifies an object of the dependency graph.
+type DependencyRef struct {
+	Kind DependencyKind `json:"kind"`
+	ID   string         `json:"id"`
+}
+
+// String renders the reference as kind:id, e.g. script:41.
+func (r DependencyRef) String() string {
+	return string(r.Kind) + ":" + r.ID
+}
+
+type DependencyNode struct {
+	DependencyRef
+	Name string `json:"name,omitempty"`
+}
+
+// DependencyEdge tells that From uses To, e.g. a policy that runs a script.
+// Priority, Action and Arguments describe how it is used, when it applies.
+type DependencyEdge struct {
+	From      DependencyRef    `json:"from"`
+	To        DependencyRef    `json:"to"`
+	Priority  string           `json:"priority,omitempty"`
+	Action    string           `json:"action,omitempty"`
+	Arguments []ScriptArgument `json:"arguments,omitempty"`
+}
+
+// DependencyGraph links policies to the scripts, packages, printers, dock
+// items and category they use, and scripts to their category.
+type DependencyGraph struct {
+	Nodes []DependencyNode `json:"nodes"`
+	Edges []DependencyEdge `json:"edges"`
+	// index locates the first indexed nodes, see nodeIndex
+	index   map[DependencyRef]int
+	indexed int
+}
+
+// GetDependencyGraph reads every policy and script and builds their
+// dependency graph. It makes one request per policy.
+func (c *Client) GetDependencyGraph() (*DependencyGraph, error) {
+	overview, err := c.GetPolicies()
+	if err != nil {
+		return nil, err
+	}
+	policies := make([]*Policy, 0, len(overview.Policy))
+	for _, o := range overview.Policy {
+		p, err := c.GetPolicy(o.ID)
+		if err != nil {
+			return nil, fmt.Errorf("get policy %d: %w", o.ID, err)
+		}
+		policies = append(policies, p)
+	}
+
+	var scripts []Script
+	pager := c.PageScripts(PageOpts{})
+	for pager.Next() {
+		scripts = append(scripts, pager.Scripts()...)
+	}
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	return NewDependencyGraph(policies, scripts), nil
+}
+
+// NewDependencyGraph builds the dependency graph of the given policies and
+// scripts. The scripts give the names and parameter labels of the scripts
+// run by the policies; a script run by a policy but missing from scripts is
+// still part of the graph.
+func NewDependencyGraph(policies []*Policy, scripts []Script) *DependencyGraph {
+	g := &DependencyGraph{
+		Nodes: []DependencyNode{},
+		Edges: []DependencyEdge{},
+	}
+
+	scriptsByID := make(map[string]*Script, len(scripts))
+	for i := range scripts {
+		s := &scripts[i]
+		scriptsByID[s.ID] = s
+		ref := g.addNode(DependencyScript, s.ID, s.Name)
+		if s.CategoryID != "" && s.CategoryID != "-1" {
+			to := g.addNode(DependencyCategory, s.CategoryID, s.CategoryName)
+			g.Edges = append(g.Edges, DependencyEdge{From: ref, To: to})
+		}
+	}
+
+	for _, p := range policies {
+		if p.General == nil {
+			continue
+		}
+		from := g.addNode(DependencyPolicy, fmt.Sprint(p.General.ID), p.General.Name)
+		g.addPolicyEdges(from, p, scriptsByID)
+	}
+
+	return g
+}
+
+func (g *DependencyGraph) addPolicyEdges(from DependencyRef, p *Policy, scripts map[string]*Script) {
+	if c := p.General.Category; c != nil && c.ID > 0 {
+		to := g.addNode(DependencyCategory, fmt.Sprint(c.ID), c.Name)
+		g.Edges = append(g.Edges, DependencyEdge{From: from, To: to})
+	}
+
+	if p.Scripts != nil {
+		for _, ps := range p.Scripts.PolicyScript {
+			id := strconv.FormatUint(uint64(ps.ID), 10)
+			s, ok := scripts[id]
+			if !ok {
+				s = &Script{ID: id, Name: ps.Name}
+			}
+			to := g.addNode(DependencyScript, id, s.Name)
+			g.Edges = append(g.Edges, DependencyEdge{
+				From:      from,
+				To:        to,
+				Priority:  string(ps.Priority),
+				Arguments: s.Arguments(ps),
+			})
+		}
+	}
+
+	if p.PackageConfiguration != nil && p.PackageConfiguration.Packages != nil {
+		for _, pkg := range p.PackageConfiguration.Packages.Package {
+			to := g.addNode(DependencyPackage, fmt.Sprint(pkg.ID), pkg.Name)
+			g.Edges = append(g.Edges, DependencyEdge{From: from, To: to, Action: string(pkg.Action)})
+		}
+	}
+
+	if p.Printers != nil {
+		for _, pr := range p.Printers.Printer {
+			to := g.addNode(DependencyPrinter, fmt.Sprint(pr.ID), pr.Name)
+			g.Edges = append(g.Edges, DependencyEdge{From: from, To: to, Action: string(pr.Action)})
+		}
+	}
+
+	if p.DockItems != nil {
+		for _, d := range p.DockItems.DockItem {
+			to := g.addNode(DependencyDockItem, fmt.Sprint(d.ID), d.Name)
+			g.Edges = append(g.Edges, DependencyEdge{From: from, To: to, Action: string(d.Action)})
+		}
+	}
+}
+
+// addNode adds the node unless it exists, in which case only a missing name
+// is filled in.
+func (g *DependencyGraph) addNode(kind DependencyKind, id, name string) DependencyRef {
+	ref := DependencyRef{Kind: kind, ID: id}
+	if i, ok := g.nodeIndex()[ref]; ok {
+		if g.Nodes[i].Name == "" {
+			g.Nodes[i].Name = name
+		}
+		return ref
+	}
+	g.Nodes = append(g.Nodes, DependencyNode{DependencyRef: ref, Name: name})
+	return ref
+}
+
+// nodeIndex returns the position of every node by reference. Nodes added to
+// Nodes since the last call, e.g. all of them in a graph decoded from JSON,
+// are indexed first.
+func (g *DependencyGraph) nodeIndex() map[DependencyRef]int {
+	if g.index == nil || g.indexed > len(g.Nodes) {
+		g.index = make(map[DependencyRef]int, len(g.Nodes))
+		g.indexed = 0
+	}
+	for ; g.indexed < len(g.Nodes); g.indexed++ {
+		ref := g.Nodes[g.indexed].DependencyRef
+		if _, ok := g.index[ref]; !ok {
+			g.index[ref] = g.indexed
+		}
+	}
+	return g.index
+}
+
+// Node returns the node of the object, if it is part of the graph.
+func (g *DependencyGraph) Node(kind DependencyKind, id string) (DependencyNode, bool) {
+	i, ok := g.nodeIndex()[DependencyRef{Kind: kind, ID: id}]
+	if !ok {
+		return DependencyNode{}, false
+	}
+	return g.Nodes[i], true
+}
+
+// Dependents returns the edges to the object, i.e. what uses it.
+func (g *DependencyGraph) Dependents(kind DependencyKind, id string) []DependencyEdge {
+	ref := DependencyRef{Kind: kind, ID: id}
+	var edges []DependencyEdge
+	for _, e := range g.Edges {
+		if e.To == ref {
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+// Dependencies returns the edges from the object, i.e. what it uses.
+func (g *DependencyGraph) Dependencies(kind DependencyKind, id string) []DependencyEdge {
+	ref := DependencyRef{Kind: kind, ID: id}
+	var edges []DependencyEdge
+	for _, e := range g.Edges {
+		if e.From == ref {
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+// PoliciesUsingScript returns the edges of the policies that run the script,
+// with the priority and arguments each policy runs it with.
+func (g *DependencyGraph) PoliciesUsingScript(scriptID string) []DependencyEdge {
+	var edges []DependencyEdge
+	for _, e := range g.Dependents(DependencyScript, scriptID) {
+		if e.From.Kind == DependencyPolicy {
+			edges = append(edges, e)
+		}
+	}
+	return edges
+}
+
+// JSON renders the graph as indented JSON.
+func (g *DependencyGraph) JSON() ([]byte, error) {
+	return json.MarshalIndent(g, "", "  ")
+}
+
+// DOT renders the graph in the Graphviz DOT language, e.g. for
+// `dot -Tsvg -o dependencies.svg`.
+func (g *DependencyGraph) DOT() string {
+	var b strings.Builder
+	b.WriteString("digraph dependencies {\n")
+	b.WriteString("  rankdir=LR;\n")
+
+	nodes := append([]DependencyNode(nil), g.Nodes...)
+	sort.SliceStable(nodes, func(i, j int) bool {
+		return nodes[i].Kind < nodes[j].Kind
+	})
+	for _, n := range nodes {
+		label := string(n.Kind) + " " + n.ID
+		if n.Name != "" {
+			label += "\n" + n.Name
+		}
+		fmt.Fprintf(&b, "  %q [label=%q, shape=%s];\n", n.String(), label, dotShape(n.Kind))
+	}
+
+	for _, e := range g.Edges {
+		var attrs []string
+		if e.Priority != "" {
+			attrs = append(attrs, e.Priority)
+		}
+		if e.Action != "" {
+			attrs = append(attrs, e.Action)
+		}
+		for _, a := range e.Arguments {
+			attrs = append(attrs, a.String())
+		}
+		if len(attrs) > 0 {
+			fmt.Fprintf(&b, "  %q -> %q [label=%q];\n", e.From.String(), e.To.String(), strings.Join(attrs, "\n"))
+		} else {
+			fmt.Fprintf(&b, "  %q -> %q;\n", e.From.String(), e.To.String())
+		}
+	}
+
+	b.WriteString("}\n")
+	return b.String()
+}
+
+func dotShape(kind DependencyKind) string {
+	switch kind {
+	case DependencyPolicy:
+		return "box"
+	case DependencyScript:
+		return "note"
+	case DependencyCategory:
+		return "folder"
+	}
+	return "ellipse"
+}
@@ -0,0 +1,136 @@
+package jamf_pro_go
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func testDependencyGraph() *DependencyGraph {
+	return NewDependencyGraph([]*Policy{{
+		General: &PolicyGeneral{ID: 5, Name: "Install", Category: &PolicyCategory{ID: 3, Name: "Tools"}},
+		Scripts: &PolicyScripts{PolicyScript: []*PolicyScript{
+			{ID: 41, Priority: "Before", Parameter4: "1.2"},
+			{ID: 43, Name: "missing.sh"},
+		}},
+		PackageConfiguration: &PolicyPackageConfiguration{Packages: &PolicyPackages{Package: []*PolicyPackage{
+			{ID: 7, Name: "app.pkg", Action: "Install"},
+		}}},
+	}, {
+		// policies without general are skipped
+	}}, []Script{
+		{ID: "41", Name: "install.sh", CategoryID: "3", Parameter4: "Version"},
+		{ID: "42", Name: "unused.sh", CategoryID: "-1"},
+	})
+}
+
+func TestNewDependencyGraph(t *testing.T) {
+	g := testDependencyGraph()
+
+	var nodes []string
+	for _, n := range g.Nodes {
+		nodes = append(nodes, n.String()+" "+n.Name)
+	}
+	wantNodes := []string{
+		"script:41 install.sh",
+		"category:3 Tools", // named by the policy
+		"script:42 unused.sh",
+		"policy:5 Install",
+		"script:43 missing.sh",
+		"package:7 app.pkg",
+	}
+	if !reflect.DeepEqual(nodes, wantNodes) {
+		t.Errorf("nodes = %q, want %q", nodes, wantNodes)
+	}
+
+	policy := DependencyRef{Kind: DependencyPolicy, ID: "5"}
+	wantDependencies := []DependencyEdge{
+		{From: policy, To: DependencyRef{Kind: DependencyCategory, ID: "3"}},
+		{From: policy, To: DependencyRef{Kind: DependencyScript, ID: "41"}, Priority: "Before",
+			Arguments: []ScriptArgument{{Slot: 4, Label: "Version", Value: "1.2"}}},
+		{From: policy, To: DependencyRef{Kind: DependencyScript, ID: "43"}},
+		{From: policy, To: DependencyRef{Kind: DependencyPackage, ID: "7"}, Action: "Install"},
+	}
+	if got := g.Dependencies(DependencyPolicy, "5"); !reflect.DeepEqual(got, wantDependencies) {
+		t.Errorf("Dependencies(policy 5) = %+v, want %+v", got, wantDependencies)
+	}
+
+	var dependents []string
+	for _, e := range g.Dependents(DependencyCategory, "3") {
+		dependents = append(dependents, e.From.String())
+	}
+	if want := []string{"script:41", "policy:5"}; !reflect.DeepEqual(dependents, want) {
+		t.Errorf("Dependents(category 3) = %v, want %v", dependents, want)
+	}
+
+	if got := g.PoliciesUsingScript("41"); len(got) != 1 || got[0].From != policy {
+		t.Errorf("PoliciesUsingScript(41) = %+v", got)
+	}
+	if got := g.PoliciesUsingScript("42"); got != nil {
+		t.Errorf("PoliciesUsingScript(42) = %+v, want none", got)
+	}
+}
+
+func TestDependencyGraphNode(t *testing.T) {
+	g := testDependencyGraph()
+	if n, ok := g.Node(DependencyScript, "41"); !ok || n.Name != "install.sh" {
+		t.Errorf("Node(script 41) = %+v, %v", n, ok)
+	}
+	if _, ok := g.Node(DependencyPolicy, "41"); ok {
+		t.Error("Node(policy 41) found, want none")
+	}
+
+	// nodes appended by hand are found too
+	g.Nodes = append(g.Nodes, DependencyNode{DependencyRef: DependencyRef{Kind: DependencyPrinter, ID: "9"}})
+	if _, ok := g.Node(DependencyPrinter, "9"); !ok {
+		t.Error("Node(printer 9) not found after appending it")
+	}
+}
+
+func TestDependencyGraphJSONRoundTrip(t *testing.T) {
+	b, err := testDependencyGraph().JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var g DependencyGraph
+	if err := json.Unmarshal(b, &g); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"arguments": [`) {
+		t.Errorf("JSON has no arguments:\n%s", b)
+	}
+
+	if n, ok := g.Node(DependencyPackage, "7"); !ok || n.Name != "app.pkg" {
+		t.Errorf("Node(package 7) = %+v, %v", n, ok)
+	}
+
+	// a decoded graph can be built further
+	nodes := len(g.Nodes)
+	from := g.addNode(DependencyPolicy, "6", "Update")
+	g.addPolicyEdges(from, &Policy{
+		General: &PolicyGeneral{ID: 6},
+		Scripts: &PolicyScripts{PolicyScript: []*PolicyScript{{ID: 41}}},
+	}, nil)
+	if len(g.Nodes) != nodes+1 {
+		t.Errorf("%d nodes, want %d", len(g.Nodes), nodes+1)
+	}
+	if got := g.PoliciesUsingScript("41"); len(got) != 2 {
+		t.Errorf("PoliciesUsingScript(41) = %+v, want two policies", got)
+	}
+}
+
+func TestDependencyGraphDOT(t *testing.T) {
+	dot := testDependencyGraph().DOT()
+	for _, want := range []string{
+		"digraph dependencies {\n",
+		`  "policy:5" [label="policy 5\nInstall", shape=box];`,
+		`  "category:3" [label="category 3\nTools", shape=folder];`,
+		`  "policy:5" -> "script:41" [label="Before\nVersion=1.2"];`,
+		`  "policy:5" -> "category:3";`,
+	} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("DOT has no %s:\n%s", want, dot)
+		}
+	}
+}
@@ -0,0 +1,388 @@
+package jamf_pro_go
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "modified"
+)
+
+// Change is a single difference between two objects.
+// Path is built from the XML element names, e.g. general.frequency, and list
+// items are addressed by ID (or name when they have no ID on either side),
+// e.g. scripts[41].parameter5.
+type Change struct {
+	Path string      `json:"path"`
+	Kind ChangeKind  `json:"kind"`
+	Old  interface{} `json:"old,omitempty"`
+	New  interface{} `json:"new,omitempty"`
+}
+
+type Diff struct {
+	Changes []Change `json:"changes"`
+}
+
+// DiffPolicies reports the differences from policy `from` to policy `to`.
+// Size counters are ignored and list items are matched by ID, so reordering
+// a list is not a change.
+func DiffPolicies(from, to *Policy) *Diff {
+	d := &Diff{Changes: []Change{}}
+	d.diff("", reflect.ValueOf(from), reflect.ValueOf(to))
+	return d
+}
+
+// Empty reports whether there are no differences.
+func (d *Diff) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+// String renders the diff as one line per change.
+func (d *Diff) String() string {
+	var b strings.Builder
+	for _, c := range d.Changes {
+		switch c.Kind {
+		case ChangeAdded:
+			fmt.Fprintf(&b, "+ %s: %s\n", c.Path, formatDiffValue(c.New))
+		case ChangeRemoved:
+			fmt.Fprintf(&b, "- %s: %s\n", c.Path, formatDiffValue(c.Old))
+		default:
+			fmt.Fprintf(&b, "~ %s: %s -> %s\n", c.Path, formatDiffValue(c.Old), formatDiffValue(c.New))
+		}
+	}
+	return b.String()
+}
+
+// JSON renders the diff as indented JSON.
+func (d *Diff) JSON() ([]byte, error) {
+	return json.MarshalIndent(d, "", "  ")
+}
+
+func (d *Diff) add(path string, kind ChangeKind, old, new interface{}) {
+	d.Changes = append(d.Changes, Change{Path: path, Kind: kind, Old: old, New: new})
+}
+
+var rawXMLElementsType = reflect.TypeOf([]RawXMLElement(nil))
+
+func (d *Diff) diff(path string, a, b reflect.Value) {
+	t := a.Type()
+
+	if t.Kind() == reflect.Ptr {
+		elem := t.Elem()
+		if elem.Kind() != reflect.Struct {
+			// optional value, nil means unset
+			if a.IsNil() && b.IsNil() {
+				return
+			}
+			if a.IsNil() || b.IsNil() || !leafEqual(a.Elem(), b.Elem()) {
+				d.add(path, ChangeModified, leafValue(a), leafValue(b))
+			}
+			return
+		}
+		// a nil section is compared as an empty one
+		if a.IsNil() {
+			a = reflect.Zero(elem)
+		} else {
+			a = a.Elem()
+		}
+		if b.IsNil() {
+			b = reflect.Zero(elem)
+		} else {
+			b = b.Elem()
+		}
+		t = elem
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name := strings.Split(f.Tag.Get("xml"), ",")[0]
+			if f.Name == "XMLName" || (f.Name == "Size" && name == "size") {
+				continue
+			}
+			if f.Type == rawXMLElementsType {
+				d.diffRawElements(path, a.Field(i), b.Field(i))
+				continue
+			}
+			if f.Type.Kind() == reflect.Slice {
+				// list items are addressed directly under the list wrapper
+				d.diffList(path, name, a.Field(i), b.Field(i))
+				continue
+			}
+			d.diff(joinDiffPath(path, name), a.Field(i), b.Field(i))
+		}
+	default:
+		if !leafEqual(a, b) {
+			d.add(path, ChangeModified, leafValue(a), leafValue(b))
+		}
+	}
+}
+
+func (d *Diff) diffList(path, itemName string, a, b reflect.Value) {
+	if a.Type().Elem().Kind() != reflect.Ptr || a.Type().Elem().Elem().Kind() != reflect.Struct {
+		// lists of plain values are compared as a whole, ignoring order
+		if !sameValueSet(a, b) {
+			d.add(path, ChangeModified, a.Interface(), b.Interface())
+		}
+		return
+	}
+
+	aKeys, aOK := listKeys(a)
+	bKeys, bOK := listKeys(b)
+	if !aOK || !bOK {
+		aKeys, bKeys = indexListKeys(a.Len()), indexListKeys(b.Len())
+	}
+
+	pairs := matchListKeys(aKeys, bKeys)
+	matched := make(map[int]bool, len(pairs))
+	for i, k := range bKeys {
+		j, ok := pairs[i]
+		if !ok {
+			d.add(path+"["+k.String()+"]", ChangeAdded, nil, itemXML(itemName, b.Index(i)))
+			continue
+		}
+		matched[j] = true
+		// an item that got its ID on one side only is addressed by the ID
+		if k.id == "" {
+			k = aKeys[j]
+		}
+		d.diff(path+"["+k.String()+"]", a.Index(j), b.Index(i))
+	}
+	for i, k := range aKeys {
+		if !matched[i] {
+			d.add(path+"["+k.String()+"]", ChangeRemoved, itemXML(itemName, a.Index(i)), nil)
+		}
+	}
+}
+
+// diffRawElements compares unknown elements by name. Elements repeated under
+// the same name are matched by position, e.g. scope.zzz[1].
+func (d *Diff) diffRawElements(path string, a, b reflect.Value) {
+	bByName, names := rawElementsByName(b.Interface().([]RawXMLElement), nil)
+	aByName, names := rawElementsByName(a.Interface().([]RawXMLElement), names)
+	for _, name := range names {
+		as, bs := aByName[name], bByName[name]
+		for i := 0; i < len(as) || i < len(bs); i++ {
+			elemPath := joinDiffPath(path, name)
+			if len(as) > 1 || len(bs) > 1 {
+				elemPath = fmt.Sprintf("%s[%d]", elemPath, i)
+			}
+			switch {
+			case i >= len(as):
+				d.add(elemPath, ChangeAdded, nil, bs[i])
+			case i >= len(bs):
+				d.add(elemPath, ChangeRemoved, as[i], nil)
+			case as[i] != bs[i]:
+				d.add(elemPath, ChangeModified, as[i], bs[i])
+			}
+		}
+	}
+}
+
+// rawElementsByName returns the inner XML of the elements by name, and
+// appends the names not in names yet to it.
+func rawElementsByName(elements []RawXMLElement, names []string) (map[string][]string, []string) {
+	byName := map[string][]string{}
+	for _, e := range elements {
+		name := e.XMLName.Local
+		byName[name] = append(byName[name], string(e.InnerXML))
+	}
+	for _, e := range elements {
+		if !containsString(names, e.XMLName.Local) {
+			names = append(names, e.XMLName.Local)
+		}
+	}
+	return byName, names
+}
+
+func containsString(list []string, v string) bool {
+	for _, s := range list {
+		if s == v {
+			return true
+		}
+	}
+	return false
+}
+
+// listKey identifies a list item by its ID and its name, either of which
+// may be empty.
+type listKey struct {
+	id, name string
+}
+
+func (k listKey) String() string {
+	if k.id != "" {
+		return k.id
+	}
+	return k.name
+}
+
+// listKeys returns the key of every list item. It fails if an item has
+// neither an ID nor a name, or if two items have the same key.
+func listKeys(list reflect.Value) ([]listKey, bool) {
+	keys := make([]listKey, list.Len())
+	seen := map[listKey]bool{}
+	for i := 0; i < list.Len(); i++ {
+		item := list.Index(i)
+		if item.IsNil() {
+			return nil, false
+		}
+		item = item.Elem()
+		var key listKey
+		if f := item.FieldByName("ID"); f.IsValid() && !f.IsZero() {
+			key.id = fmt.Sprint(f.Interface())
+		}
+		if f := item.FieldByName("Name"); f.IsValid() && f.String() != "" {
+			key.name = fmt.Sprintf("%q", f.String())
+		} else if f := item.FieldByName("UserName"); f.IsValid() && f.String() != "" {
+			key.name = fmt.Sprintf("%q", f.String())
+		}
+		if key == (listKey{}) || seen[listKey{id: key.id}] || (key.id == "" && seen[key]) {
+			return nil, false
+		}
+		if key.id != "" {
+			seen[listKey{id: key.id}] = true
+		} else {
+			seen[key] = true
+		}
+		keys[i] = key
+	}
+	return keys, true
+}
+
+// matchListKeys pairs the items of b with the items of a, returning the
+// index in a by index in b. Items are matched by ID, and by name when one of
+// them has no ID, e.g. a script added to a policy by name only.
+func matchListKeys(a, b []listKey) map[int]int {
+	pairs := map[int]int{}
+	aByID := map[string]int{}
+	for i, k := range a {
+		if k.id != "" {
+			aByID[k.id] = i
+		}
+	}
+	matched := map[int]bool{}
+	for i, k := range b {
+		if j, ok := aByID[k.id]; ok && k.id != "" {
+			pairs[i] = j
+			matched[j] = true
+		}
+	}
+
+	// names are only used when they are unique among the unmatched items
+	aByName := map[string][]int{}
+	for i, k := range a {
+		if !matched[i] && k.name != "" {
+			aByName[k.name] = append(aByName[k.name], i)
+		}
+	}
+	bByName := map[string][]int{}
+	for i, k := range b {
+		if _, ok := pairs[i]; !ok && k.name != "" {
+			bByName[k.name] = append(bByName[k.name], i)
+		}
+	}
+	for name, bs := range bByName {
+		as := aByName[name]
+		if len(as) != 1 || len(bs) != 1 {
+			continue
+		}
+		if a[as[0]].id != "" && b[bs[0]].id != "" {
+			// different IDs are different objects, whatever their names
+			continue
+		}
+		pairs[bs[0]] = as[0]
+	}
+	return pairs
+}
+
+// itemXML renders an added or removed list item as classic XML.
+func itemXML(name string, item reflect.Value) string {
+	var b bytes.Buffer
+	err := xml.NewEncoder(&b).EncodeElement(item.Interface(), xml.StartElement{Name: xml.Name{Local: name}})
+	if err != nil {
+		return fmt.Sprint(item.Interface())
+	}
+	return b.String()
+}
+
+func indexListKeys(n int) []listKey {
+	keys := make([]listKey, n)
+	for i := range keys {
+		keys[i] = listKey{id: fmt.Sprintf("#%d", i)}
+	}
+	return keys
+}
+
+func sameValueSet(a, b reflect.Value) bool {
+	count := map[interface{}]int{}
+	for i := 0; i < a.Len(); i++ {
+		count[a.Index(i).Interface()]++
+	}
+	for i := 0; i < b.Len(); i++ {
+		count[b.Index(i).Interface()]--
+	}
+	for _, n := range count {
+		if n != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// leafEqual compares two scalar values. Enumerated values are compared in
+// their normalised spelling.
+func leafEqual(a, b reflect.Value) bool {
+	if m, ok := a.Interface().(encoding.TextMarshaler); ok {
+		at, aErr := m.MarshalText()
+		bt, bErr := b.Interface().(encoding.TextMarshaler).MarshalText()
+		if aErr == nil && bErr == nil {
+			return bytes.Equal(at, bt)
+		}
+	}
+	return a.Interface() == b.Interface()
+}
+
+func leafValue(v reflect.Value) interface{} {
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	return v.Interface()
+}
+
+func formatDiffValue(v interface{}) string {
+	if v == nil {
+		return "<unset>"
+	}
+	if m, ok := v.(encoding.TextMarshaler); ok {
+		if text, err := m.MarshalText(); err == nil {
+			return fmt.Sprintf("%q", text)
+		}
+	}
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.String, reflect.Slice:
+		return fmt.Sprintf("%q", v)
+	}
+	return fmt.Sprint(v)
+}
+
+func joinDiffPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,137 @@
+package jamf_pro_go
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"reflect"
+	"testing"
+)
+
+func policyWithScripts(scripts ...*PolicyScript) *Policy {
+	return &Policy{Scripts: &PolicyScripts{PolicyScript: scripts}}
+}
+
+func TestDiffPolicies(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		from, to *Policy
+		want     []Change
+	}{
+		{
+			"reordered scripts",
+			policyWithScripts(&PolicyScript{ID: 41, Name: "install"}, &PolicyScript{ID: 42, Name: "update"}),
+			policyWithScripts(&PolicyScript{ID: 42, Name: "update"}, &PolicyScript{ID: 41, Name: "install"}),
+			nil,
+		},
+		{
+			"parameter",
+			policyWithScripts(&PolicyScript{ID: 41, Parameter5: "old"}),
+			policyWithScripts(&PolicyScript{ID: 41, Parameter5: "new"}),
+			[]Change{{Path: "scripts[41].parameter5", Kind: ChangeModified, Old: "old", New: "new"}},
+		},
+		{
+			"added and removed scripts",
+			policyWithScripts(&PolicyScript{ID: 41}),
+			policyWithScripts(&PolicyScript{ID: 42}),
+			[]Change{
+				{Path: "scripts[42]", Kind: ChangeAdded, New: "<script><id>42</id></script>"},
+				{Path: "scripts[41]", Kind: ChangeRemoved, Old: "<script><id>41</id></script>"},
+			},
+		},
+		{
+			"script by name only",
+			policyWithScripts(&PolicyScript{ID: 41, Name: "install", Parameter4: "a"}),
+			policyWithScripts(&PolicyScript{Name: "install", Parameter4: "b"}),
+			[]Change{
+				{Path: "scripts[41].id", Kind: ChangeModified, Old: uint32(41), New: uint32(0)},
+				{Path: "scripts[41].parameter4", Kind: ChangeModified, Old: "a", New: "b"},
+			},
+		},
+		{
+			"same name, different IDs",
+			policyWithScripts(&PolicyScript{ID: 41, Name: "install"}),
+			policyWithScripts(&PolicyScript{ID: 42, Name: "install"}),
+			[]Change{
+				{Path: "scripts[42]", Kind: ChangeAdded, New: "<script><id>42</id><name>install</name></script>"},
+				{Path: "scripts[41]", Kind: ChangeRemoved, Old: "<script><id>41</id><name>install</name></script>"},
+			},
+		},
+		{
+			"unknown elements",
+			&Policy{General: &PolicyGeneral{UnknownElements: []RawXMLElement{
+				{XMLName: xml.Name{Local: "kept"}, InnerXML: []byte("1")},
+				{XMLName: xml.Name{Local: "changed"}, InnerXML: []byte("old")},
+				{XMLName: xml.Name{Local: "removed"}, InnerXML: []byte("x")},
+			}}},
+			&Policy{General: &PolicyGeneral{UnknownElements: []RawXMLElement{
+				{XMLName: xml.Name{Local: "added"}, InnerXML: []byte("y")},
+				{XMLName: xml.Name{Local: "changed"}, InnerXML: []byte("new")},
+				{XMLName: xml.Name{Local: "kept"}, InnerXML: []byte("1")},
+			}}},
+			[]Change{
+				{Path: "general.added", Kind: ChangeAdded, New: "y"},
+				{Path: "general.changed", Kind: ChangeModified, Old: "old", New: "new"},
+				{Path: "general.removed", Kind: ChangeRemoved, Old: "x"},
+			},
+		},
+		{
+			"repeated unknown elements",
+			&Policy{Scope: &PolicyScope{UnknownElements: []RawXMLElement{
+				{XMLName: xml.Name{Local: "zzz"}, InnerXML: []byte("2")},
+				{XMLName: xml.Name{Local: "zzz"}, InnerXML: []byte("3")},
+				{XMLName: xml.Name{Local: "yyy"}, InnerXML: []byte("1")},
+			}}},
+			&Policy{Scope: &PolicyScope{UnknownElements: []RawXMLElement{
+				{XMLName: xml.Name{Local: "zzz"}, InnerXML: []byte("2")},
+				{XMLName: xml.Name{Local: "zzz"}, InnerXML: []byte("9")},
+				{XMLName: xml.Name{Local: "yyy"}, InnerXML: []byte("1")},
+				{XMLName: xml.Name{Local: "yyy"}, InnerXML: []byte("4")},
+			}}},
+			[]Change{
+				{Path: "scope.zzz[1]", Kind: ChangeModified, Old: "3", New: "9"},
+				{Path: "scope.yyy[1]", Kind: ChangeAdded, New: "4"},
+			},
+		},
+	} {
+		got := DiffPolicies(tt.from, tt.to)
+		if len(got.Changes) == 0 && len(tt.want) == 0 {
+			continue
+		}
+		if !reflect.DeepEqual(got.Changes, tt.want) {
+			t.Errorf("%s: DiffPolicies() =\n%s\nwant %+v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDiffJSON(t *testing.T) {
+	d := DiffPolicies(
+		policyWithScripts(&PolicyScript{ID: 41, Parameter5: "old"}),
+		policyWithScripts(&PolicyScript{ID: 41, Parameter5: "new"}, &PolicyScript{ID: 42}),
+	)
+	b, err := d.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got map[string][]map[string]interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+	want := map[string][]map[string]interface{}{
+		"changes": {
+			{"path": "scripts[41].parameter5", "kind": "modified", "old": "old", "new": "new"},
+			{"path": "scripts[42]", "kind": "added", "new": "<script><id>42</id></script>"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("JSON() = %s", b)
+	}
+
+	empty, err := DiffPolicies(&Policy{}, &Policy{}).JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(empty) != "{\n  \"changes\": []\n}" {
+		t.Errorf("JSON() of no changes = %s", empty)
+	}
+}
@@ -0,0 +1,453 @@
+package jamf_pro_go
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// Enumerated string values
+//
+// Values are matched case-insensitively and written in the spelling the API
+// expects. Unknown values, e.g. ones added by a newer Jamf Pro, are kept as
+// they are both ways, so an object read from the API can always be written
+// back. Validate rejects them, and the Create and Update functions check
+// every enumerated field before sending a request, see validateEnums.
+
+type ScriptPriority string
+
+const (
+	ScriptPriorityBefore   ScriptPriority = "BEFORE"
+	ScriptPriorityAfter    ScriptPriority = "AFTER"
+	ScriptPriorityAtReboot ScriptPriority = "AT_REBOOT"
+)
+
+var scriptPriorities = []string{
+	string(ScriptPriorityBefore),
+	string(ScriptPriorityAfter),
+	string(ScriptPriorityAtReboot),
+}
+
+func (v ScriptPriority) Validate() error {
+	_, err := normalizeEnum("script priority", string(v), scriptPriorities)
+	return err
+}
+
+func (v ScriptPriority) MarshalText() ([]byte, error) {
+	return marshalEnum(string(v), scriptPriorities)
+}
+
+func (v *ScriptPriority) UnmarshalText(text []byte) error {
+	*v = ScriptPriority(unmarshalEnum(text, scriptPriorities))
+	return nil
+}
+
+type PolicyScriptPriority string
+
+const (
+	PolicyScriptPriorityBefore PolicyScriptPriority = "Before"
+	PolicyScriptPriorityAfter  PolicyScriptPriority = "After"
+)
+
+var policyScriptPriorities = []string{
+	string(PolicyScriptPriorityBefore),
+	string(PolicyScriptPriorityAfter),
+}
+
+func (v PolicyScriptPriority) Validate() error {
+	_, err := normalizeEnum("policy script priority", string(v), policyScriptPriorities)
+	return err
+}
+
+func (v PolicyScriptPriority) MarshalText() ([]byte, error) {
+	return marshalEnum(string(v), policyScriptPriorities)
+}
+
+func (v *PolicyScriptPriority) UnmarshalText(text []byte) error {
+	*v = PolicyScriptPriority(unmarshalEnum(text, policyScriptPriorities))
+	return nil
+}
+
+type PolicyFrequency string
+
+const (
+	PolicyFrequencyOncePerComputer        PolicyFrequency = "Once per computer"
+	PolicyFrequencyOncePerUserPerComputer PolicyFrequency = "Once per user per computer"
+	PolicyFrequencyOncePerUser            PolicyFrequency = "Once per user"
+	PolicyFrequencyOnceEveryDay           PolicyFrequency = "Once every day"
+	PolicyFrequencyOnceEveryWeek          PolicyFrequency = "Once every week"
+	PolicyFrequencyOnceEveryMonth         PolicyFrequency = "Once every month"
+	PolicyFrequencyOngoing                PolicyFrequency = "Ongoing"
+)
+
+var policyFrequencies = []string{
+	string(PolicyFrequencyOncePerComputer),
+	string(PolicyFrequencyOncePerUserPerComputer),
+	string(PolicyFrequencyOncePerUser),
+	string(PolicyFrequencyOnceEveryDay),
+	string(PolicyFrequencyOnceEveryWeek),
+	string(PolicyFrequencyOnceEveryMonth),
+	string(PolicyFrequencyOngoing),
+}
+
+func (v PolicyFrequency) Validate() error {
+	_, err := normalizeEnum("policy frequency", string(v), policyFrequencies)
+	return err
+}
+
+func (v PolicyFrequency) MarshalText() ([]byte, error) {
+	return marshalEnum(string(v), policyFrequencies)
+}
+
+func (v *PolicyFrequency) UnmarshalText(text []byte) error {
+	*v = PolicyFrequency(unmarshalEnum(text, policyFrequencies))
+	return nil
+}
+
+type PackageAction string
+
+const (
+	PackageActionInstall       PackageAction = "Install"
+	PackageActionCache         PackageAction = "Cache"
+	PackageActionInstallCached PackageAction = "Install Cached"
+	PackageActionUninstall     PackageAction = "Uninstall"
+)
+
+var packageActions = []string{
+	string(PackageActionInstall),
+	string(PackageActionCache),
+	string(PackageActionInstallCached),
+	string(PackageActionUninstall),
+}
+
+func (v PackageAction) Validate() error {
+	_, err := normalizeEnum("package action", string(v), packageActions)
+	return err
+}
+
+func (v PackageAction) MarshalText() ([]byte, error) {
+	return marshalEnum(string(v), packageActions)
+}
+
+func (v *PackageAction) UnmarshalText(text []byte) error {
+	*v = PackageAction(unmarshalEnum(text, packageActions))
+	return nil
+}
+
+type PrinterAction string
+
+const (
+	PrinterActionInstall   PrinterAction = "install"
+	PrinterActionUninstall PrinterAction = "uninstall"
+)
+
+var printerActions = []string{
+	string(PrinterActionInstall),
+	string(PrinterActionUninstall),
+}
+
+func (v PrinterAction) Validate() error {
+	_, err := normalizeEnum("printer action", string(v), printerActions)
+	return err
+}
+
+func (v PrinterAction) MarshalText() ([]byte, error) {
+	return marshalEnum(string(v), printerActions)
+}
+
+func (v *PrinterAction) UnmarshalText(text []byte) error {
+	*v = PrinterAction(unmarshalEnum(text, printerActions))
+	return nil
+}
+
+type DockItemAction string
+
+const (
+	DockItemActionAddToBeginning DockItemAction = "Add To Beginning"
+	DockItemActionAddToEnd       DockItemAction = "Add To End"
+	DockItemActionRemove         DockItemAction = "Remove"
+)
+
+var dockItemActions = []string{
+	string(DockItemActionAddToBeginning),
+	string(DockItemActionAddToEnd),
+	string(DockItemActionRemove),
+}
+
+func (v DockItemAction) Validate() error {
+	_, err := normalizeEnum("dock item action", string(v), dockItemActions)
+	return err
+}
+
+func (v DockItemAction) MarshalText() ([]byte, error) {
+	return marshalEnum(string(v), dockItemActions)
+}
+
+func (v *DockItemAction) UnmarshalText(text []byte) error {
+	*v = DockItemAction(unmarshalEnum(text, dockItemActions))
+	return nil
+}
+
+type AccountAction string
+
+const (
+	AccountActionCreate           AccountAction = "Create"
+	AccountActionReset            AccountAction = "Reset"
+	AccountActionDelete           AccountAction = "Delete"
+	AccountActionDisableFileVault AccountAction = "DisableFileVault"
+)
+
+var accountActions = []string{
+	string(AccountActionCreate),
+	string(AccountActionReset),
+	string(AccountActionDelete),
+	string(AccountActionDisableFileVault),
+}
+
+func (v AccountAction) Validate() error {
+	_, err := normalizeEnum("account action", string(v), accountActions)
+	return err
+}
+
+func (v AccountAction) MarshalText() ([]byte, error) {
+	return marshalEnum(string(v), accountActions)
+}
+
+func (v *AccountAction) UnmarshalText(text []byte) error {
+	*v = AccountAction(unmarshalEnum(text, accountActions))
+	return nil
+}
+
+type ManagementAccountAction string
+
+const (
+	ManagementAccountActionDoNotChange      ManagementAccountAction = "doNotChange"
+	ManagementAccountActionSpecified        ManagementAccountAction = "specified"
+	ManagementAccountActionRandom           ManagementAccountAction = "random"
+	ManagementAccountActionReset            ManagementAccountAction = "reset"
+	ManagementAccountActionFileVaultEnable  ManagementAccountAction = "fileVaultEnable"
+	ManagementAccountActionFileVaultDisable ManagementAccountAction = "fileVaultDisable"
+)
+
+var managementAccountActions = []string{
+	string(ManagementAccountActionDoNotChange),
+	string(ManagementAccountActionSpecified),
+	string(ManagementAccountActionRandom),
+	string(ManagementAccountActionReset),
+	string(ManagementAccountActionFileVaultEnable),
+	string(ManagementAccountActionFileVaultDisable),
+}
+
+func (v ManagementAccountAction) Validate() error {
+	_, err := normalizeEnum("management account action", string(v), managementAccountActions)
+	return err
+}
+
+func (v ManagementAccountAction) MarshalText() ([]byte, error) {
+	return marshalEnum(string(v), managementAccountActions)
+}
+
+func (v *ManagementAccountAction) UnmarshalText(text []byte) error {
+	*v = ManagementAccountAction(unmarshalEnum(text, managementAccountActions))
+	return nil
+}
+
+type DiskEncryptionAction string
+
+const (
+	DiskEncryptionActionNone      DiskEncryptionAction = "none"
+	DiskEncryptionActionApply     DiskEncryptionAction = "apply"
+	DiskEncryptionActionRemediate DiskEncryptionAction = "remediate"
+)
+
+var diskEncryptionActions = []string{
+	string(DiskEncryptionActionNone),
+	string(DiskEncryptionActionApply),
+	string(DiskEncryptionActionRemediate),
+}
+
+func (v DiskEncryptionAction) Validate() error {
+	_, err := normalizeEnum("disk encryption action", string(v), diskEncryptionActions)
+	return err
+}
+
+func (v DiskEncryptionAction) MarshalText() ([]byte, error) {
+	return marshalEnum(string(v), diskEncryptionActions)
+}
+
+func (v *DiskEncryptionAction) UnmarshalText(text []byte) error {
+	*v = DiskEncryptionAction(unmarshalEnum(text, diskEncryptionActions))
+	return nil
+}
+
+type CriterionAndOr string
+
+const (
+	CriterionAnd CriterionAndOr = "and"
+	CriterionOr  CriterionAndOr = "or"
+)
+
+var criterionAndOrs = []string{
+	string(CriterionAnd),
+	string(CriterionOr),
+}
+
+func (v CriterionAndOr) Validate() error {
+	_, err := normalizeEnum("criterion and_or", string(v), criterionAndOrs)
+	return err
+}
+
+func (v CriterionAndOr) MarshalText() ([]byte, error) {
+	return marshalEnum(string(v), criterionAndOrs)
+}
+
+func (v *CriterionAndOr) UnmarshalText(text []byte) error {
+	*v = CriterionAndOr(unmarshalEnum(text, criterionAndOrs))
+	return nil
+}
+
+// InventorySection is a section of the computer inventory, see
+// ComputerInventoryOpts.
+type InventorySection string
+
+const (
+	InventorySectionGeneral               InventorySection = "GENERAL"
+	InventorySectionDiskEncryption        InventorySection = "DISK_ENCRYPTION"
+	InventorySectionPurchasing            InventorySection = "PURCHASING"
+	InventorySectionApplications          InventorySection = "APPLICATIONS"
+	InventorySectionStorage               InventorySection = "STORAGE"
+	InventorySectionUserAndLocation       InventorySection = "USER_AND_LOCATION"
+	InventorySectionConfigurationProfiles InventorySection = "CONFIGURATION_PROFILES"
+	InventorySectionPrinters              InventorySection = "PRINTERS"
+	InventorySectionServices              InventorySection = "SERVICES"
+	InventorySectionHardware              InventorySection = "HARDWARE"
+	InventorySectionLocalUserAccounts     InventorySection = "LOCAL_USER_ACCOUNTS"
+	InventorySectionCertificates          InventorySection = "CERTIFICATES"
+	InventorySectionAttachments           InventorySection = "ATTACHMENTS"
+	InventorySectionPlugins               InventorySection = "PLUGINS"
+	InventorySectionPackageReceipts       InventorySection = "PACKAGE_RECEIPTS"
+	InventorySectionFonts                 InventorySection = "FONTS"
+	InventorySectionSecurity              InventorySection = "SECURITY"
+	InventorySectionOperatingSystem       InventorySection = "OPERATING_SYSTEM"
+	InventorySectionLicensedSoftware      InventorySection = "LICENSED_SOFTWARE"
+	InventorySectionIBeacons              InventorySection = "IBEACONS"
+	InventorySectionSoftwareUpdates       InventorySection = "SOFTWARE_UPDATES"
+	InventorySectionExtensionAttributes   InventorySection = "EXTENSION_ATTRIBUTES"
+	InventorySectionContentCaching        InventorySection = "CONTENT_CACHING"
+	InventorySectionGroupMemberships      InventorySection = "GROUP_MEMBERSHIPS"
+)
+
+var inventorySections = []string{
+	string(InventorySectionGeneral),
+	string(InventorySectionDiskEncryption),
+	string(InventorySectionPurchasing),
+	string(InventorySectionApplications),
+	string(InventorySectionStorage),
+	string(InventorySectionUserAndLocation),
+	string(InventorySectionConfigurationProfiles),
+	string(InventorySectionPrinters),
+	string(InventorySectionServices),
+	string(InventorySectionHardware),
+	string(InventorySectionLocalUserAccounts),
+	string(InventorySectionCertificates),
+	string(InventorySectionAttachments),
+	string(InventorySectionPlugins),
+	string(InventorySectionPackageReceipts),
+	string(InventorySectionFonts),
+	string(InventorySectionSecurity),
+	string(InventorySectionOperatingSystem),
+	string(InventorySectionLicensedSoftware),
+	string(InventorySectionIBeacons),
+	string(InventorySectionSoftwareUpdates),
+	string(InventorySectionExtensionAttributes),
+	string(InventorySectionContentCaching),
+	string(InventorySectionGroupMemberships),
+}
+
+func (v InventorySection) Validate() error {
+	_, err := normalizeEnum("inventory section", string(v), inventorySections)
+	return err
+}
+
+func (v InventorySection) MarshalText() ([]byte, error) {
+	return marshalEnum(string(v), inventorySections)
+}
+
+func (v *InventorySection) UnmarshalText(text []byte) error {
+	*v = InventorySection(unmarshalEnum(text, inventorySections))
+	return nil
+}
+
+// normalizeEnum returns the allowed spelling of value.
+// An empty value is valid and means the field is unset.
+func normalizeEnum(name, value string, allowed []string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	for _, a := range allowed {
+		if strings.EqualFold(a, value) {
+			return a, nil
+		}
+	}
+	return value, &InvalidValueError{Name: name, Value: value, Allowed: allowed}
+}
+
+// marshalEnum writes value in its allowed spelling, or as it is if unknown.
+func marshalEnum(value string, allowed []string) ([]byte, error) {
+	v, _ := normalizeEnum("", value, allowed)
+	return []byte(v), nil
+}
+
+func unmarshalEnum(text []byte, allowed []string) string {
+	v, _ := normalizeEnum("", strings.TrimSpace(string(text)), allowed)
+	return v
+}
+
+// enumValue is implemented by the enumerated string types.
+type enumValue interface {
+	Validate() error
+}
+
+// validateEnums checks every enumerated field of v and returns a
+// *ValidationError naming the fields holding unknown values.
+func validateEnums(v interface{}) error {
+	e := &ValidationError{}
+	walkEnums(e, "", reflect.ValueOf(v))
+	return e.err()
+}
+
+func walkEnums(e *ValidationError, path string, v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			walkEnums(e, path, v.Elem())
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			name := strings.Split(f.Tag.Get("xml"), ",")[0]
+			if name == "" {
+				name = strings.Split(f.Tag.Get("json"), ",")[0]
+			}
+			if name == "" || name == "-" {
+				name = f.Name
+			}
+			walkEnums(e, joinDiffPath(path, name), v.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return
+		}
+		for i := 0; i < v.Len(); i++ {
+			walkEnums(e, fmt.Sprintf("%s[%d]", path, i), v.Index(i))
+		}
+	case reflect.String:
+		if enum, ok := v.Interface().(enumValue); ok {
+			checkEnum(e, path, enum.Validate())
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package jamf_pro_go
+
+import (
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestEnumMarshalNormalizesCase(t *testing.T) {
+	b, err := xml.Marshal(&PolicyGeneral{Frequency: "once PER computer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "<PolicyGeneral><frequency>Once per computer</frequency></PolicyGeneral>"
+	if string(b) != want {
+		t.Errorf("got %s, want %s", b, want)
+	}
+}
+
+func TestEnumUnknownValueRoundTrips(t *testing.T) {
+	var p Policy
+	err := xml.Unmarshal([]byte(`<policy><general><id>1</id><frequency>Once every two weeks</frequency></general></policy>`), &p)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := p.Copy()
+	if err != nil {
+		t.Fatalf("Copy: %v", err)
+	}
+	if c.General.Frequency != "Once every two weeks" {
+		t.Errorf("frequency = %q", c.General.Frequency)
+	}
+
+	var invalid *InvalidValueError
+	if err := c.General.Frequency.Validate(); !errors.As(err, &invalid) {
+		t.Errorf("Validate() = %v, want an *InvalidValueError", err)
+	}
+}
+
+func TestCreateAndUpdateRejectInvalidEnums(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte("<policy><id>1</id></policy>"))
+	}))
+	defer srv.Close()
+	c := NewClient(&Config{BaseURL: srv.URL})
+
+	policy := &Policy{General: &PolicyGeneral{Name: "p", Frequency: "every hour"}}
+	group := NewSmartComputerGroup("g", &ComputerGroupCriterion{Name: "A", AndOr: "xor"})
+	script := ScriptParams{Name: "a.sh", Priority: "bogus"}
+
+	for _, tt := range []struct {
+		name  string
+		call  func() error
+		field string
+	}{
+		{"CreatePolicy", func() error { _, err := c.CreatePolicy(policy); return err }, "general.frequency"},
+		{"UpdatePolicy", func() error { _, err := c.UpdatePolicy(1, policy); return err }, "general.frequency"},
+		{"CreateScript", func() error { _, err := c.CreateScript(script); return err }, "priority"},
+		{"UpdateScript", func() error { _, err := c.UpdateScript(1, script); return err }, "priority"},
+		{"CreateComputerGroup", func() error { _, err := c.CreateComputerGroup(group); return err }, "criteria.criterion[0].and_or"},
+		{"UpdateComputerGroup", func() error { _, err := c.UpdateComputerGroup(1, group); return err }, "criteria.criterion[0].and_or"},
+	} {
+		var v *ValidationError
+		if err := tt.call(); !errors.As(err, &v) || len(v.Problems) != 1 || v.Problems[0].Field != tt.field {
+			t.Errorf("%s: error = %v, want a *ValidationError for %s", tt.name, err, tt.field)
+		}
+	}
+	if requests != 0 {
+		t.Errorf("%d requests sent, want none", requests)
+	}
+
+	// known values in another case are accepted
+	if _, err := c.CreatePolicy(&Policy{General: &PolicyGeneral{Name: "p", Frequency: "ONGOING"}}); err != nil {
+		t.Errorf("CreatePolicy with a known frequency: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("%d requests sent, want 1", requests)
+	}
+}
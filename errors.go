@@ -1,5 +1,11 @@
 package jamf_pro_go
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
 const (
 	XXXXXXXXXX      = "[error message]"
 )
@@ -18,3 +24,102 @@ type Error struct {
 func (e *Error) Error() string {
 	return e.RawError
 }
+
+// ErrPolicyModified is returned by PatchPolicy when the policy was changed
+// on the server between reading and writing it.
+var ErrPolicyModified = errors.New("[jamf-pro-go] policy was modified concurrently")
+
+// InvalidValueError is returned when an enumerated field holds a value the
+// API does not accept.
+type InvalidValueError struct {
+	Name    string
+	Value   string
+	Allowed []string
+}
+
+func (e *InvalidValueError) Error() string {
+	return fmt.Sprintf("[jamf-pro-go] invalid %s %q (allowed: %s)", e.Name, e.Value, strings.Join(e.Allowed, ", "))
+}
+
+// ValidationError holds all problems found while validating an object
+// before it is sent to the API.
+type ValidationError struct {
+	Problems []ValidationProblem
+}
+
+type ValidationProblem struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, 0, len(e.Problems))
+	for _, p := range e.Problems {
+		msgs = append(msgs, p.Field+": "+p.Message)
+	}
+	return "[jamf-pro-go] validation failed: " + strings.Join(msgs, "; ")
+}
+
+func (e *ValidationError) add(field, format string, a ...interface{}) {
+	e.Problems = append(e.Problems, ValidationProblem{Field: field, Message: fmt.Sprintf(format, a...)})
+}
+
+// err returns nil if no problem was found.
+func (e *ValidationError) err() error {
+	if len(e.Problems) == 0 {
+		return nil
+	}
+	return e
+}
+
+// ScriptLintError is returned by CreateScript and UpdateScript when
+// Config.LintScripts is set and the script has lint errors.
+type ScriptLintError struct {
+	Name     string
+	Findings []LintFinding
+}
+
+func (e *ScriptLintError) Error() string {
+	msgs := make([]string, 0, len(e.Findings))
+	for _, f := range e.Findings {
+		if f.Severity == LintError {
+			msgs = append(msgs, f.String())
+		}
+	}
+	return fmt.Sprintf("[jamf-pro-go] script %q has lint errors: %s", e.Name, strings.Join(msgs, "; "))
+}
+
+// DependencyError is returned by the safe delete functions when the object
+// to delete is still used. Dependents lists the objects using it, and Scope
+// the computers and computer groups an enabled policy still runs on.
+type DependencyError struct {
+	Object     DependencyNode
+	Dependents []DependencyNode
+	Scope      []DependencyNode
+}
+
+func (e *DependencyError) Error() string {
+	var reasons []string
+	if len(e.Dependents) > 0 {
+		reasons = append(reasons, "is used by "+formatDependencyNodes(e.Dependents))
+	}
+	if len(e.Scope) > 0 {
+		reasons = append(reasons, "is enabled and scoped to "+formatDependencyNodes(e.Scope))
+	}
+	return fmt.Sprintf("[jamf-pro-go] %s %s", formatDependencyNode(e.Object), strings.Join(reasons, " and "))
+}
+
+func formatDependencyNodes(nodes []DependencyNode) string {
+	s := make([]string, 0, len(nodes))
+	for _, n := range nodes {
+		s = append(s, formatDependencyNode(n))
+	}
+	return strings.Join(s, ", ")
+}
+
+func formatDependencyNode(n DependencyNode) string {
+	if n.Name == "" {
+		return fmt.Sprintf("%s %s", n.Kind, n.ID)
+	}
+	return fmt.Sprintf("%s %s (%q)", n.Kind, n.ID, n.Name)
+}
@@ -146,7 +146,7 @@ func main() {
 		General: &jamf.PolicyGeneral{
 			//ID: 0,
 			Name: "sample_policy",
-			Enabled: false,
+			Enabled: jamf.Bool(false),
 		},
 		Scripts: &jamf.PolicyScripts{
 			PolicyScript: []*jamf.PolicyScript{
@@ -173,7 +173,7 @@ func main() {
 		General: &jamf.PolicyGeneral{
 			//ID: targetPolicyID,
 			Name: "sample_policy_update",
-			Enabled: true,
+			Enabled: jamf.Bool(true),
 		},
 		Scripts: &jamf.PolicyScripts{
 			PolicyScript: []*jamf.PolicyScript{
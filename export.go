@@ -0,0 +1,27 @@
+package jamf_pro_go
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// ExportOpts are the options of the Jamf Pro API CSV exports.
+type ExportOpts struct {
+	Page     uint32        `json:"page"`
+	PageSize uint32        `json:"pageSize,omitempty"`
+	Sort     []string      `json:"sort,omitempty"`   // e.g. "id:asc"
+	Filter   string        `json:"filter,omitempty"` // RSQL, e.g. `categoryName=="cat_1"`
+	Fields   []ExportField `json:"fields,omitempty"` // all fields if empty
+}
+
+// ExportField selects a column of the export and optionally renames it.
+type ExportField struct {
+	FieldName          string `json:"fieldName"`
+	FieldLabelOverride string `json:"fieldLabelOverride,omitempty"`
+}
+
+// readCSVRecords parses a CSV export, including its header row, and closes it.
+func readCSVRecords(r io.ReadCloser) ([][]string, error) {
+	defer r.Close()
+	return csv.NewReader(r).ReadAll()
+}
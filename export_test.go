@@ -0,0 +1,70 @@
+package jamf_pro_go
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestExportScriptsRecords(t *testing.T) {
+	var body, accept, contentType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/uapi/v1/scripts/export" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		b, _ := ioutil.ReadAll(r.Body)
+		body, accept, contentType = string(b), r.Header.Get("Accept"), r.Header.Get("Content-Type")
+		w.Write([]byte("ID,Script name,Notes\n41,install.sh,\"two\nlines, quoted \"\"x\"\"\"\n42,update.sh,\n"))
+	}))
+	defer srv.Close()
+	c := NewClient(&Config{BaseURL: srv.URL})
+
+	records, err := c.ExportScriptsRecords(ExportOpts{
+		PageSize: 100,
+		Sort:     []string{"id:asc"},
+		Filter:   `categoryName=="Tools"`,
+		Fields: []ExportField{
+			{FieldName: "id", FieldLabelOverride: "ID"},
+			{FieldName: "name", FieldLabelOverride: "Script name"},
+			{FieldName: "notes"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := `{"page":0,"pageSize":100,"sort":["id:asc"],"filter":"categoryName==\"Tools\"",` +
+		`"fields":[{"fieldName":"id","fieldLabelOverride":"ID"},{"fieldName":"name","fieldLabelOverride":"Script name"},{"fieldName":"notes"}]}`
+	if body != want {
+		t.Errorf("body = %s, want %s", body, want)
+	}
+	if accept != "text/csv" || contentType != "application/json" {
+		t.Errorf("Accept = %q, Content-Type = %q", accept, contentType)
+	}
+
+	wantRecords := [][]string{
+		{"ID", "Script name", "Notes"},
+		{"41", "install.sh", "two\nlines, quoted \"x\""},
+		{"42", "update.sh", ""},
+	}
+	if !reflect.DeepEqual(records, wantRecords) {
+		t.Errorf("records = %q, want %q", records, wantRecords)
+	}
+}
+
+func TestExportScriptsRecordsDefaults(t *testing.T) {
+	c, requests, done := recordRequests(t, "ID,Name\n41\n")
+	defer done()
+
+	// all fields are exported when none is given
+	_, err := c.ExportScriptsRecords(ExportOpts{})
+	if want := `POST /uapi/v1/scripts/export {"page":0}`; len(*requests) != 1 || (*requests)[0] != want {
+		t.Errorf("requests = %q, want %q", *requests, want)
+	}
+	// the row is shorter than the header
+	if err == nil {
+		t.Error("ExportScriptsRecords() of malformed CSV succeeded")
+	}
+}
@@ -0,0 +1,52 @@
+package jamf_pro_go
+
+import "time"
+
+// History is a page of the change history of a Jamf Pro API object.
+type History struct {
+	TotalCount uint32         `json:"totalCount"`
+	Results    []HistoryEntry `json:"results"`
+}
+
+type HistoryEntry struct {
+	ID       string    `json:"id"`
+	Username string    `json:"username"`
+	Date     time.Time `json:"date"`
+	Note     string    `json:"note"`
+	Details  string    `json:"details"`
+}
+
+type HistoryNoteParams struct {
+	Note string `json:"note"`
+}
+
+type CreateHistoryNoteResult struct {
+	ID   string `json:"id"`
+	Href string `json:"href"`
+}
+
+type HistoryPager struct {
+	*Pager
+	page *History
+}
+
+// History returns the entries of the current page.
+func (p *HistoryPager) History() []HistoryEntry {
+	if p.page == nil {
+		return nil
+	}
+	return p.page.Results
+}
+
+func newHistoryPager(opts PageOpts, get func(PageOpts) (*History, error)) *HistoryPager {
+	p := &HistoryPager{}
+	p.Pager = newPager(opts, func(opts PageOpts) (int, uint32, error) {
+		page, err := get(opts)
+		if err != nil {
+			return 0, 0, err
+		}
+		p.page = page
+		return len(page.Results), page.TotalCount, nil
+	})
+	return p
+}
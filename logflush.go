@@ -0,0 +1,169 @@
+package jamf_pro_go
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	APIVersionLogFlush = "classic"
+	APIPathLogFlush    = "logflush"
+)
+
+// LogFlushInterval is the age of the logs to flush, in days.
+// Logs older than the interval are flushed; LogFlushZeroDays flushes all logs.
+type LogFlushInterval int
+
+const (
+	LogFlushZeroDays    LogFlushInterval = 0
+	LogFlushOneDay      LogFlushInterval = 1
+	LogFlushOneWeek     LogFlushInterval = 7
+	LogFlushTwoWeeks    LogFlushInterval = 14
+	LogFlushOneMonth    LogFlushInterval = 30
+	LogFlushThreeMonths LogFlushInterval = 90
+	LogFlushSixMonths   LogFlushInterval = 180
+	LogFlushOneYear     LogFlushInterval = 365
+)
+
+var logFlushIntervalNames = map[LogFlushInterval]string{
+	LogFlushZeroDays:    "Zero Days",
+	LogFlushOneDay:      "One Day",
+	LogFlushOneWeek:     "One Week",
+	LogFlushTwoWeeks:    "Two Weeks",
+	LogFlushOneMonth:    "One Month",
+	LogFlushThreeMonths: "Three Months",
+	LogFlushSixMonths:   "Six Months",
+	LogFlushOneYear:     "One Year",
+}
+
+// LogFlushIntervalOf returns the interval matching the duration exactly.
+// Months count as 30 days and a year as 365 days.
+func LogFlushIntervalOf(d time.Duration) (LogFlushInterval, error) {
+	day := 24 * time.Hour
+	if d%day == 0 {
+		interval := LogFlushInterval(d / day)
+		if _, ok := logFlushIntervalNames[interval]; ok {
+			return interval, nil
+		}
+	}
+	return 0, fmt.Errorf("[jamf-pro-go] no log flush interval matches %v", d)
+}
+
+func (i LogFlushInterval) Duration() time.Duration {
+	return time.Duration(i) * 24 * time.Hour
+}
+
+// String returns the interval as the API spells it, e.g. "Three Months".
+func (i LogFlushInterval) String() string {
+	if name, ok := logFlushIntervalNames[i]; ok {
+		return name
+	}
+	return fmt.Sprintf("LogFlushInterval(%d)", int(i))
+}
+
+func (i LogFlushInterval) MarshalText() ([]byte, error) {
+	name, ok := logFlushIntervalNames[i]
+	if !ok {
+		return nil, fmt.Errorf("[jamf-pro-go] invalid log flush interval %d", int(i))
+	}
+	return []byte(name), nil
+}
+
+// pathSegment returns the interval as it is written in a URL, e.g. "Three+Months".
+func (i LogFlushInterval) pathSegment() (string, error) {
+	name, err := i.MarshalText()
+	if err != nil {
+		return "", err
+	}
+	return strings.ReplaceAll(string(name), " ", "+"), nil
+}
+
+// LogFlushParams is the request body of FlushLogs.
+type LogFlushParams struct {
+	XMLName        xml.Name                `xml:"logflush"`
+	Log            string                  `xml:"log"`              // [ policy ]
+	LogID          uint32                  `xml:"log_id,omitempty"` // policy ID, all policies if unset
+	Interval       LogFlushInterval        `xml:"interval"`
+	Computers      *LogFlushComputers      `xml:"computers,omitempty"`
+	ComputerGroups *LogFlushComputerGroups `xml:"computer_groups,omitempty"`
+}
+
+type LogFlushComputers struct {
+	Computer []LogFlushTarget `xml:"computer"`
+}
+
+type LogFlushComputerGroups struct {
+	ComputerGroup []LogFlushTarget `xml:"computer_group"`
+}
+
+type LogFlushTarget struct {
+	ID uint32 `xml:"id"`
+}
+
+// FlushPolicyLogs flushes the logs of a policy older than the interval.
+func (c *Client) FlushPolicyLogs(policyID uint32, interval LogFlushInterval) error {
+	segment, err := interval.pathSegment()
+	if err != nil {
+		return err
+	}
+	return c.flushLogs(path.Join(APIPathLogFlush, "policy", "id", fmt.Sprint(policyID), "interval", segment), nil)
+}
+
+// FlushAllPolicyLogs flushes the logs of all policies older than the interval.
+func (c *Client) FlushAllPolicyLogs(interval LogFlushInterval) error {
+	segment, err := interval.pathSegment()
+	if err != nil {
+		return err
+	}
+	return c.flushLogs(path.Join(APIPathLogFlush, "policies", "interval", segment), nil)
+}
+
+// FlushComputerPolicyLogs flushes the policy logs of computers older than the interval.
+func (c *Client) FlushComputerPolicyLogs(interval LogFlushInterval, computerIDs ...uint32) error {
+	computers := &LogFlushComputers{}
+	for _, id := range computerIDs {
+		computers.Computer = append(computers.Computer, LogFlushTarget{ID: id})
+	}
+	return c.FlushLogs(&LogFlushParams{Log: "policy", Interval: interval, Computers: computers})
+}
+
+// FlushComputerGroupPolicyLogs flushes the policy logs of the computers in the
+// groups older than the interval.
+func (c *Client) FlushComputerGroupPolicyLogs(interval LogFlushInterval, groupIDs ...uint32) error {
+	groups := &LogFlushComputerGroups{}
+	for _, id := range groupIDs {
+		groups.ComputerGroup = append(groups.ComputerGroup, LogFlushTarget{ID: id})
+	}
+	return c.FlushLogs(&LogFlushParams{Log: "policy", Interval: interval, ComputerGroups: groups})
+}
+
+// FlushLogs flushes logs in bulk as described by params.
+// Empty Computers or ComputerGroups are an error rather than sent as is.
+func (c *Client) FlushLogs(params *LogFlushParams) error {
+	if params.Computers != nil && len(params.Computers.Computer) == 0 {
+		return fmt.Errorf("[jamf-pro-go] missing computer IDs to flush logs of")
+	}
+	if params.ComputerGroups != nil && len(params.ComputerGroups.ComputerGroup) == 0 {
+		return fmt.Errorf("[jamf-pro-go] missing computer group IDs to flush logs of")
+	}
+	return c.flushLogs(APIPathLogFlush, params)
+}
+
+func (c *Client) flushLogs(apiPath string, params *LogFlushParams) error {
+	var body interface{}
+	if params != nil {
+		body = params
+	}
+	err := c.call(apiPath, http.MethodDelete, APIVersionLogFlush, nil, body, nil)
+	if err != nil && err != io.EOF {
+		return err
+	}
+	c.logf("[jamf-pro-go] Logs are flushed: %s", apiPath)
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package jamf_pro_go
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFlushLogsRejectsEmptyIDs(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+	}))
+	defer srv.Close()
+	c := NewClient(&Config{BaseURL: srv.URL})
+
+	if err := c.FlushComputerPolicyLogs(LogFlushOneWeek); err == nil {
+		t.Error("FlushComputerPolicyLogs() without IDs: no error")
+	}
+	if err := c.FlushComputerGroupPolicyLogs(LogFlushOneWeek); err == nil {
+		t.Error("FlushComputerGroupPolicyLogs() without IDs: no error")
+	}
+	if err := c.FlushLogs(&LogFlushParams{Log: "policy", Computers: &LogFlushComputers{}}); err == nil {
+		t.Error("FlushLogs() with empty computers: no error")
+	}
+	if requests != 0 {
+		t.Errorf("%d requests sent, want none", requests)
+	}
+
+	if err := c.FlushComputerPolicyLogs(LogFlushOneWeek, 1, 2); err != nil {
+		t.Errorf("FlushComputerPolicyLogs(): %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("%d requests sent, want 1", requests)
+	}
+}
@@ -0,0 +1,20 @@
+package jamf_pro_go
+
+// Optional values
+//
+// Boolean fields of the policy model are pointers so that an unset field
+// (nil, omitted from the request) can be told apart from an explicit false.
+// Use Bool to set a field and BoolValue to read one.
+
+// Bool returns a pointer to the given bool value.
+func Bool(v bool) *bool {
+	return &v
+}
+
+// BoolValue returns the value of the bool pointer, or false if it is nil.
+func BoolValue(p *bool) bool {
+	if p == nil {
+		return false
+	}
+	return *p
+}
@@ -0,0 +1,86 @@
+package jamf_pro_go
+
+// DefaultPageSize is used by pagers when PageOpts.PageSize is not set.
+const DefaultPageSize = 100
+
+// PageOpts are the paging, sorting and filtering options of the Jamf Pro API
+// collections.
+type PageOpts struct {
+	Page     uint32   `url:"page,omitempty"`
+	PageSize uint32   `url:"page-size,omitempty"`
+	Sort     []string `url:"sort,omitempty"`   // e.g. "name:asc"
+	Filter   string   `url:"filter,omitempty"` // RSQL, e.g. `name=="foo"`
+}
+
+// Pager walks through the pages of a Jamf Pro API collection, starting at
+// PageOpts.Page. The resource specific pagers embed it and give access to
+// the current page.
+//
+//	pager := client.PageScripts(jamf.PageOpts{PageSize: 50})
+//	for pager.Next() {
+//		for _, script := range pager.Scripts() {
+//			...
+//		}
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager struct {
+	opts    PageOpts
+	fetch   func(opts PageOpts) (count int, totalCount uint32, err error)
+	started bool
+	done    bool
+	seen    uint32
+	total   uint32
+	err     error
+}
+
+func newPager(opts PageOpts, fetch func(opts PageOpts) (int, uint32, error)) *Pager {
+	if opts.PageSize == 0 {
+		opts.PageSize = DefaultPageSize
+	}
+	return &Pager{opts: opts, fetch: fetch}
+}
+
+// Next fetches the next page and reports whether it holds any results.
+func (p *Pager) Next() bool {
+	if p.done || p.err != nil {
+		return false
+	}
+	if p.started {
+		p.opts.Page++
+	}
+	p.started = true
+
+	count, total, err := p.fetch(p.opts)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	p.total = total
+	p.seen += uint32(count)
+	if count == 0 {
+		p.done = true
+		return false
+	}
+	// a short page does not end the walk, the server may cap the page size
+	if p.seen >= total {
+		p.done = true
+	}
+	return true
+}
+
+// Err returns the error that stopped the pager, if any.
+func (p *Pager) Err() error {
+	return p.err
+}
+
+// Page returns the index of the current page.
+func (p *Pager) Page() uint32 {
+	return p.opts.Page
+}
+
+// TotalCount returns the number of results in the whole collection.
+func (p *Pager) TotalCount() uint32 {
+	return p.total
+}
@@ -0,0 +1,58 @@
+package jamf_pro_go
+
+import "testing"
+
+// fakePages serves total items in pages of at most limit items, whatever
+// page size is asked for.
+func fakePages(total, limit int, requests *int) func(PageOpts) (int, uint32, error) {
+	return func(opts PageOpts) (int, uint32, error) {
+		*requests++
+		size := int(opts.PageSize)
+		if size > limit {
+			size = limit
+		}
+		start := int(opts.Page) * size
+		count := total - start
+		if count > size {
+			count = size
+		}
+		if count < 0 {
+			count = 0
+		}
+		return count, uint32(total), nil
+	}
+}
+
+func TestPager(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		total, limit int
+		pageSize     uint32
+		wantItems    int
+		wantRequests int
+	}{
+		{"empty", 0, 100, 10, 0, 1},
+		{"single page", 7, 100, 10, 7, 1},
+		{"exact pages", 20, 100, 10, 20, 2},
+		{"last page short", 25, 100, 10, 25, 3},
+		{"server caps page size", 25, 10, 100, 25, 3},
+	} {
+		requests, items := 0, 0
+		p := newPager(PageOpts{PageSize: tt.pageSize}, func(opts PageOpts) (int, uint32, error) {
+			count, total, err := fakePages(tt.total, tt.limit, &requests)(opts)
+			items += count
+			return count, total, err
+		})
+		for p.Next() {
+		}
+		if err := p.Err(); err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		if items != tt.wantItems || requests != tt.wantRequests {
+			t.Errorf("%s: %d items in %d requests, want %d in %d", tt.name, items, requests, tt.wantItems, tt.wantRequests)
+		}
+		if p.TotalCount() != uint32(tt.total) {
+			t.Errorf("%s: TotalCount() = %d, want %d", tt.name, p.TotalCount(), tt.total)
+		}
+	}
+}
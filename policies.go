@@ -12,86 +12,129 @@ const (
 	APIPathPolices        = "policies"
 )
 
+// Policy is the classic API policy object.
+// It is used both for the result of GetPolicy and as the request body of
+// CreatePolicy and UpdatePolicy, so a fetched policy can be submitted again.
 type Policy struct {
-	General               *PolicyGeneral              `xml:"general,omitempty"`
-	Scope                 *PolicyScope                `xml:"scope,omitempty"`
-	SelfService           *PolicySelfService          `xml:"self_service,omitempty"`
-	PackageConfiguration  *PolicyPackageConfiguration `xml:"package_configuration,omitempty"`
-	Scripts               *PolicyScripts              `xml:"scripts,omitempty"`
-	Printers              *PolicyPrinters             `xml:"printers,omitempty"`
-	DockItems             *PolicyDockItems            `xml:"dock_items,omitempty"`
-	AccountMaintenance    *PolicyAccountMaintenance   `xml:"account_maintenance,omitempty"`
-	RebootSettings        *PolicyRebootSettings       `xml:"reboot,omitempty"`
-	Maintenance           *PolicyMaintenance          `xml:"maintenance,omitempty"`
-	FilesProcesses        *PolicyFilesProcesses       `xml:"files_processes,omitempty"`
-	UserInteraction       *PolicyUserInteraction      `xml:"user_interaction,omitempty"`
-	DiskEncryption        *PolicyDiskEncryption       `xml:"disk_encryption,omitempty"`
+	XMLName              xml.Name                    `xml:"policy"`
+	General              *PolicyGeneral              `xml:"general,omitempty"`
+	Scope                *PolicyScope                `xml:"scope,omitempty"`
+	SelfService          *PolicySelfService          `xml:"self_service,omitempty"`
+	PackageConfiguration *PolicyPackageConfiguration `xml:"package_configuration,omitempty"`
+	Scripts              *PolicyScripts              `xml:"scripts,omitempty"`
+	Printers             *PolicyPrinters             `xml:"printers,omitempty"`
+	DockItems            *PolicyDockItems            `xml:"dock_items,omitempty"`
+	AccountMaintenance   *PolicyAccountMaintenance   `xml:"account_maintenance,omitempty"`
+	RebootSettings       *PolicyRebootSettings       `xml:"reboot,omitempty"`
+	Maintenance          *PolicyMaintenance          `xml:"maintenance,omitempty"`
+	FilesProcesses       *PolicyFilesProcesses       `xml:"files_processes,omitempty"`
+	UserInteraction      *PolicyUserInteraction      `xml:"user_interaction,omitempty"`
+	DiskEncryption       *PolicyDiskEncryption       `xml:"disk_encryption,omitempty"`
+	UnknownElements      []RawXMLElement             `xml:",any"`
+}
+
+// Copy returns a deep copy of the policy.
+func (p *Policy) Copy() (*Policy, error) {
+	b, err := xml.Marshal(p)
+	if err != nil {
+		return nil, err
+	}
+	var result Policy
+	if err := xml.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// WithoutID returns a deep copy of the policy without its server-assigned ID,
+// ready to be created on this or another Jamf Pro server.
+func (p *Policy) WithoutID() (*Policy, error) {
+	result, err := p.Copy()
+	if err != nil {
+		return nil, err
+	}
+	if result.General != nil {
+		result.General.ID = 0
+	}
+	return result, nil
 }
 
 type PolicyGeneral struct {
-	ID                          uint32                      `xml:"id,omitempty"`
-	Name                        string                      `xml:"name,omitempty"`
-	Enabled                     bool                        `xml:"enabled,omitempty"`
-	Trigger                     string                      `xml:"trigger,omitempty"`
-	TriggerCheckin              bool                        `xml:"trigger_checkin,omitempty"`
-	TriggerEnrollmentComplete   bool                        `xml:"trigger_enrollment_complete,omitempty"`
-	TriggerLogin                bool                        `xml:"trigger_login,omitempty"`
-	TriggerLogout               bool                        `xml:"trigger_logout,omitempty"`
-	TriggerNetworkStateChanged  bool                        `xml:"trigger_network_state_changed,omitempty"`
-	TriggerStartup              bool                        `xml:"trigger_startup,omitempty"`
-	TriggerOther                string                      `xml:"trigger_other,omitempty"`
-	Frequency                   string                      `xml:"frequency,omitempty"`
-	Offline                     bool                        `xml:"offline,omitempty"`
-	RetryEvent                  string                      `xml:"retry_event,omitempty"`
-	RetryAttempts               int32                       `xml:"retry_attempts,omitempty"`
-	NotifyOnEachFailedRetry     bool                        `xml:"notify_on_each_failed_retry,omitempty"`
-	LocationUserOnly            string                      `xml:"location_user_only,omitempty"`
-	TargetDrive                 string                      `xml:"target_drive,omitempty"`
-	Category                    *PolicyCategory             `xml:"category,omitempty"`
-	DateTimeLimitations         *PolicyDateTimeLimitations  `xml:"date_time_limitations,omitempty"`
-	NetworkLimitations          *PolicyNetworkLimitations   `xml:"network_limitations,omitempty"`
-	OverrideDefaultSettings     *PolicyOverrides            `xml:"override_default_settings,omitempty"`
-	NetworkRequirements         string                      `xml:"network_requirements,omitempty"`
-	Site                        *PolicySite                 `xml:"site,omitempty"`
+	ID                         uint32                     `xml:"id,omitempty"`
+	Name                       string                     `xml:"name,omitempty"`
+	Enabled                    *bool                      `xml:"enabled,omitempty"`
+	Trigger                    PolicyTrigger              `xml:"trigger,omitempty"`
+	TriggerCheckin             *bool                      `xml:"trigger_checkin,omitempty"`
+	TriggerEnrollmentComplete  *bool                      `xml:"trigger_enrollment_complete,omitempty"`
+	TriggerLogin               *bool                      `xml:"trigger_login,omitempty"`
+	TriggerLogout              *bool                      `xml:"trigger_logout,omitempty"`
+	TriggerNetworkStateChanged *bool                      `xml:"trigger_network_state_changed,omitempty"`
+	TriggerStartup             *bool                      `xml:"trigger_startup,omitempty"`
+	TriggerOther               string                     `xml:"trigger_other,omitempty"`
+	Frequency                  PolicyFrequency            `xml:"frequency,omitempty"`
+	Offline                    *bool                      `xml:"offline,omitempty"`
+	RetryEvent                 string                     `xml:"retry_event,omitempty"`
+	RetryAttempts              int32                      `xml:"retry_attempts,omitempty"`
+	NotifyOnEachFailedRetry    *bool                      `xml:"notify_on_each_failed_retry,omitempty"`
+	LocationUserOnly           string                     `xml:"location_user_only,omitempty"`
+	TargetDrive                string                     `xml:"target_drive,omitempty"`
+	Category                   *PolicyCategory            `xml:"category,omitempty"`
+	DateTimeLimitations        *PolicyDateTimeLimitations `xml:"date_time_limitations,omitempty"`
+	NetworkLimitations         *PolicyNetworkLimitations  `xml:"network_limitations,omitempty"`
+	OverrideDefaultSettings    *PolicyOverrides           `xml:"override_default_settings,omitempty"`
+	NetworkRequirements        string                     `xml:"network_requirements,omitempty"`
+	Site                       *PolicySite                `xml:"site,omitempty"`
+	UnknownElements            []RawXMLElement            `xml:",any"`
 }
 
 type PolicyCategory struct {
-	ID    int32  `xml:"id,omitempty"` // default: -1
-	Name  string `xml:"name,omitempty"` // default: Unknown
+	ID              int32           `xml:"id,omitempty"`   // default: -1
+	Name            string          `xml:"name,omitempty"` // default: Unknown
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyDateTimeLimitations struct {
-	ActivationDate      string `xml:"activation_date,omitempty"`
-	ActivationDateEpoch uint64 `xml:"activation_date_epoch,omitempty"`
-	ActivationDateUtc   string `xml:"activation_date_utc,omitempty"`
-	ExpirationDate      string `xml:"expiration_date,omitempty"`
-	ExpirationDateEpoch uint64 `xml:"expiration_date_epoch,omitempty"`
-	ExpirationDateUtc   string `xml:"expiration_date_utc,omitempty"`
-	NoExecuteOn         string `xml:"no_execute_on,omitempty"` // Enum: [ Sun, Mon, Tue, Wed, Thu, Fri, Sat ]
-	NoExecuteStart      string `xml:"no_execute_start,omitempty"`
-	NoExecuteEnd        string `xml:"no_execute_end,omitempty"`
+	ActivationDate      string             `xml:"activation_date,omitempty"`
+	ActivationDateEpoch uint64             `xml:"activation_date_epoch,omitempty"`
+	ActivationDateUtc   string             `xml:"activation_date_utc,omitempty"`
+	ExpirationDate      string             `xml:"expiration_date,omitempty"`
+	ExpirationDateEpoch uint64             `xml:"expiration_date_epoch,omitempty"`
+	ExpirationDateUtc   string             `xml:"expiration_date_utc,omitempty"`
+	NoExecuteOn         *PolicyNoExecuteOn `xml:"no_execute_on,omitempty"`
+	NoExecuteStart      string             `xml:"no_execute_start,omitempty"` // e.g. 1:00 AM
+	NoExecuteEnd        string             `xml:"no_execute_end,omitempty"`   // e.g. 5:00 AM
+	UnknownElements     []RawXMLElement    `xml:",any"`
+}
+
+type PolicyNoExecuteOn struct {
+	Day             []string        `xml:"day,omitempty"` // Enum: [ Sun, Mon, Tue, Wed, Thu, Fri, Sat ]
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyNetworkLimitations struct {
-	MinimumNetworkConnection  string `xml:"minimum_network_connection,omitempty"` // Enum: [ No Minimum, Ethernet ]
-	AnyIPAddress              bool   `xml:"any_ip_address,omitempty"`
+	MinimumNetworkConnection string                      `xml:"minimum_network_connection,omitempty"` // Enum: [ No Minimum, Ethernet ]
+	AnyIPAddress             *bool                       `xml:"any_ip_address,omitempty"`
+	NetworkSegments          *PolicyScopeNetworkSegments `xml:"network_segments,omitempty"`
+	UnknownElements          []RawXMLElement             `xml:",any"`
 }
 
 type PolicyOverrides struct {
-	TargetDrive         string `xml:"target_drive,omitempty"`
-	DistributionPoint   string `xml:"distribution_point,omitempty"`
-	ForceAfpSmb         bool   `xml:"force_afp_smb,omitempty"`
-	Sus                 string `xml:"sus,omitempty"`
-	NetbootServer       string `xml:"netboot_server,omitempty"`
+	TargetDrive       string          `xml:"target_drive,omitempty"`
+	DistributionPoint string          `xml:"distribution_point,omitempty"`
+	ForceAfpSmb       *bool           `xml:"force_afp_smb,omitempty"`
+	Sus               string          `xml:"sus,omitempty"`
+	NetbootServer     string          `xml:"netboot_server,omitempty"`
+	UnknownElements   []RawXMLElement `xml:",any"`
 }
 
 type PolicySite struct {
-	ID    int32  `xml:"id,omitempty"`  // default: -1
-	Name  string `xml:"name,omitempty"`
+	ID              int32           `xml:"id,omitempty"` // default: -1
+	Name            string          `xml:"name,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyScope struct {
-	AllComputers    bool                       `xml:"all_computers,omitempty"` // default: false
+	AllComputers    *bool                      `xml:"all_computers,omitempty"` // default: false
 	Computers       *PolicyScopeComputers      `xml:"computers,omitempty"`
 	ComputerGroups  *PolicyScopeComputerGroups `xml:"computer_groups,omitempty"`
 	Buildings       *PolicyScopeBuildings      `xml:"buildings,omitempty"`
@@ -99,55 +142,62 @@ type PolicyScope struct {
 	LimitToUsers    *PolicyScopeLimitToUsers   `xml:"limit_to_users,omitempty"`
 	Limitations     *PolicyScopeLimitations    `xml:"limitations,omitempty"`
 	Exclusions      *PolicyScopeExclusions     `xml:"exclusions,omitempty"`
+	UnknownElements []RawXMLElement            `xml:",any"`
 }
 
 type PolicyScopeComputers struct {
-	Computer  []*PolicyScopeComputer `xml:"computer,omitempty"`
+	Computer        []*PolicyScopeComputer `xml:"computer,omitempty"`
+	UnknownElements []RawXMLElement        `xml:",any"`
 }
 
 type PolicyScopeComputer struct {
-	ID    uint32 `xml:"id,omitempty"`
-	Name  string `xml:"name,omitempty"`
-	UDID  string `xml:"udid,omitempty"`
+	ID              uint32          `xml:"id,omitempty"`
+	Name            string          `xml:"name,omitempty"`
+	UDID            string          `xml:"udid,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyScopeComputerGroups struct {
-	ComputerGroup  []*PolicyScopeComputerGroup `xml:"computer_group,omitempty"`
+	ComputerGroup   []*PolicyScopeComputerGroup `xml:"computer_group,omitempty"`
+	UnknownElements []RawXMLElement             `xml:",any"`
 }
 
 type PolicyScopeComputerGroup struct {
-	ID    uint32  `xml:"id,omitempty"`
-	Name  string  `xml:"name,omitempty"`
+	ID              uint32          `xml:"id,omitempty"`
+	Name            string          `xml:"name,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyScopeBuildings struct {
-	Building  []*PolicyScopeBuilding `xml:"id,omitempty"`
+	Building        []*PolicyScopeBuilding `xml:"building,omitempty"`
+	UnknownElements []RawXMLElement        `xml:",any"`
 }
 
 type PolicyScopeBuilding struct {
-	ID    uint32 `xml:"id,omitempty"`
-	Name  string `xml:"name,omitempty"`
+	ID              uint32          `xml:"id,omitempty"`
+	Name            string          `xml:"name,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyScopeDepartments struct {
-	Department  []*PolicyScopeDepartment `xml:"department,omitempty"`
+	Department      []*PolicyScopeDepartment `xml:"department,omitempty"`
+	UnknownElements []RawXMLElement          `xml:",any"`
 }
 
 type PolicyScopeDepartment struct {
-	ID    uint32 `xml:"id,omitempty"`
-	Name  string `xml:"name,omitempty"`
+	ID              uint32          `xml:"id,omitempty"`
+	Name            string          `xml:"name,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyScopeLimitToUsers struct {
-	UserGroups  *PolicyScopeLimitUserGroups `xml:"user_groups,omitempty"`
+	UserGroups      *PolicyScopeLimitUserGroups `xml:"user_groups,omitempty"`
+	UnknownElements []RawXMLElement             `xml:",any"`
 }
 
 type PolicyScopeLimitUserGroups struct {
-	UsrGroups  []*PolicyScopeLimitUserGroup `xml:"usr_groups,omitempty"`
-}
-
-type PolicyScopeLimitUserGroup struct {
-	UserGroup  string `xml:"user_group,omitempty"`
+	UserGroup       []string        `xml:"user_group,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyScopeLimitations struct {
@@ -155,240 +205,324 @@ type PolicyScopeLimitations struct {
 	UserGroups      *PolicyScopeUserGroups      `xml:"user_groups,omitempty"`
 	NetworkSegments *PolicyScopeNetworkSegments `xml:"network_segments,omitempty"`
 	Ibeacons        *PolicyScopeIbeacons        `xml:"ibeacons,omitempty"`
+	UnknownElements []RawXMLElement             `xml:",any"`
 }
 
 type PolicyScopeUsers struct {
-	User  []*PolicyScopeUsersUser `xml:"user,omitempty"`
+	User            []*PolicyScopeUsersUser `xml:"user,omitempty"`
+	UnknownElements []RawXMLElement         `xml:",any"`
 }
 
 type PolicyScopeUsersUser struct {
-	ID    uint32 `xml:"id,omitempty"`
-	Name  string `xml:"name,omitempty"`
+	ID              uint32          `xml:"id,omitempty"`
+	Name            string          `xml:"name,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyScopeUserGroups struct {
-	UserGroup  []*PolicyScopeUserGroupsUserGroup `xml:"user_group,omitempty"`
+	UserGroup       []*PolicyScopeUserGroupsUserGroup `xml:"user_group,omitempty"`
+	UnknownElements []RawXMLElement                   `xml:",any"`
 }
 
 type PolicyScopeUserGroupsUserGroup struct {
-	ID    uint32 `xml:"id,omitempty"`
-	Name  string `xml:"name,omitempty"`
+	ID              uint32          `xml:"id,omitempty"`
+	Name            string          `xml:"name,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyScopeNetworkSegments struct {
 	NetworkSegment  []*PolicyScopeNetworkSegmentsNetworkSegment `xml:"network_segment,omitempty"`
+	UnknownElements []RawXMLElement                             `xml:",any"`
 }
 
 type PolicyScopeNetworkSegmentsNetworkSegment struct {
-	ID    uint32 `xml:"id,omitempty"`
-	Name  string `xml:"name,omitempty"`
+	ID              uint32          `xml:"id,omitempty"`
+	UID             string          `xml:"uid,omitempty"`
+	Name            string          `xml:"name,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyScopeIbeacons struct {
-	Ibeacon  []*PolicyScopeIbeaconsIbeacon `xml:"ibeacon,omitempty"`
+	Ibeacon         []*PolicyScopeIbeaconsIbeacon `xml:"ibeacon,omitempty"`
+	UnknownElements []RawXMLElement               `xml:",any"`
 }
 
 type PolicyScopeIbeaconsIbeacon struct {
-	ID    uint32 `xml:"id,omitempty"`
-	Name  string `xml:"name,omitempty"`
+	ID              uint32          `xml:"id,omitempty"`
+	Name            string          `xml:"name,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyScopeExclusions struct {
-	Computers        *PolicyScopeComputers       `xml:"computers,omitempty"`
-	ComputerGroups   *PolicyScopeComputerGroups  `xml:"computer_groups,omitempty"`
-	Buildings        *PolicyScopeBuildings       `xml:"buildings,omitempty"`
-	Departments      *PolicyScopeDepartments     `xml:"departments,omitempty"`
-	Users            *PolicyScopeUsers           `xml:"users,omitempty"`
-	UserGroups       *PolicyScopeUserGroups      `xml:"user_groups,omitempty"`
-	NetworkSegments  *PolicyScopeNetworkSegments `xml:"network_segments,omitempty"`
-	Ibeacons         *PolicyScopeIbeacons        `xml:"ibeacons,omitempty"`
+	Computers       *PolicyScopeComputers       `xml:"computers,omitempty"`
+	ComputerGroups  *PolicyScopeComputerGroups  `xml:"computer_groups,omitempty"`
+	Buildings       *PolicyScopeBuildings       `xml:"buildings,omitempty"`
+	Departments     *PolicyScopeDepartments     `xml:"departments,omitempty"`
+	Users           *PolicyScopeUsers           `xml:"users,omitempty"`
+	UserGroups      *PolicyScopeUserGroups      `xml:"user_groups,omitempty"`
+	NetworkSegments *PolicyScopeNetworkSegments `xml:"network_segments,omitempty"`
+	Ibeacons        *PolicyScopeIbeacons        `xml:"ibeacons,omitempty"`
+	UnknownElements []RawXMLElement             `xml:",any"`
 }
 
 type PolicySelfService struct {
-	UseForSelfService           bool                         `xml:"use_for_self_service,omitempty"` // default: false
+	UseForSelfService           *bool                        `xml:"use_for_self_service,omitempty"` // default: false
 	SelfServiceDisplayName      string                       `xml:"self_service_display_name,omitempty"`
 	InstallButtonText           string                       `xml:"install_button_text,omitempty"`
 	ReinstallButtonText         string                       `xml:"reinstall_button_text,omitempty"`
 	SelfServiceDescription      string                       `xml:"self_service_description,omitempty"`
-	ForceUsersToViewDescription bool                         `xml:"force_users_to_view_description,omitempty"` // default: false
+	ForceUsersToViewDescription *bool                        `xml:"force_users_to_view_description,omitempty"` // default: false
 	SelfServiceIcon             *PolicySelfServiceIcon       `xml:"self_service_icon,omitempty"`
-	FeatureOnMainPage           bool                         `xml:"feature_on_main_page,omitempty"` // default: false
+	FeatureOnMainPage           *bool                        `xml:"feature_on_main_page,omitempty"` // default: false
 	SelfServiceCategories       *PolicySelfServiceCategories `xml:"self_service_categories,omitempty"`
+	Notification                *bool                        `xml:"notification,omitempty"`      // default: false
+	NotificationType            string                       `xml:"notification_type,omitempty"` // [ Self Service, Self Service and Notification Center ]
+	NotificationSubject         string                       `xml:"notification_subject,omitempty"`
+	NotificationMessage         string                       `xml:"notification_message,omitempty"`
+	UnknownElements             []RawXMLElement              `xml:",any"`
 }
 
 type PolicySelfServiceIcon struct {
-	ID    uint32 `xml:"id,omitempty"`
-	Name  string `xml:"name,omitempty"`
-	Url	  string `xml:"url,omitempty"`
+	ID              uint32          `xml:"id,omitempty"`
+	Filename        string          `xml:"filename,omitempty"`
+	URI             string          `xml:"uri,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicySelfServiceCategories struct {
-	Category  *PolicySelfServiceCategory `xml:"category,omitempty"`
+	Category        []*PolicySelfServiceCategory `xml:"category,omitempty"`
+	UnknownElements []RawXMLElement              `xml:",any"`
 }
 
 type PolicySelfServiceCategory struct {
-	ID         uint32 `xml:"id,omitempty"`
-	Name       string `xml:"name,omitempty"` // default: true
-	DisplayIn  bool   `xml:"display_in,omitempty"` // default: false
-	FeatureIn  bool   `xml:"feature_in,omitempty"`
+	ID              uint32          `xml:"id,omitempty"`
+	Name            string          `xml:"name,omitempty"`
+	DisplayIn       *bool           `xml:"display_in,omitempty"` // default: true
+	FeatureIn       *bool           `xml:"feature_in,omitempty"` // default: false
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyPackageConfiguration struct {
-	Packages  *PolicyPackages `xml:"packages,omitempty"`
+	Packages          *PolicyPackages `xml:"packages,omitempty"`
+	DistributionPoint string          `xml:"distribution_point,omitempty"` // default: default
+	UnknownElements   []RawXMLElement `xml:",any"`
 }
 
 type PolicyPackages struct {
-	Size     uint32           `xml:"size,omitempty"`
-	Package  []*PolicyPackage `xml:"package,omitempty"`
+	Size            uint32           `xml:"size,omitempty"`
+	Package         []*PolicyPackage `xml:"package,omitempty"`
+	UnknownElements []RawXMLElement  `xml:",any"`
 }
 
 type PolicyPackage struct {
-	ID             uint32 `xml:"id,omitempty"`
-	Name           string `xml:"name,omitempty"`
-	Action         string `xml:"action,omitempty"`
-	Fut            bool   `xml:"fut,omitempty"`
-	Feu            bool   `xml:"feu,omitempty"`
-	UpdateAutorun  bool   `xml:"update_autorun,omitempty"`
+	ID              uint32          `xml:"id,omitempty"`
+	Name            string          `xml:"name,omitempty"`
+	Action          PackageAction   `xml:"action,omitempty"`
+	Fut             *bool           `xml:"fut,omitempty"`
+	Feu             *bool           `xml:"feu,omitempty"`
+	UpdateAutorun   *bool           `xml:"update_autorun,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyScripts struct {
-	Size          uint32          `xml:"size,omitempty"`
-	PolicyScript  []*PolicyScript `xml:"script,omitempty"`
+	Size            uint32          `xml:"size,omitempty"`
+	PolicyScript    []*PolicyScript `xml:"script,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyScript struct {
-	ID           uint32 `xml:"id,omitempty"`
-	Name         string `xml:"name,omitempty"`
-	Priority     string `xml:"priority,omitempty"` // [ Before, After ]
-	Parameter4   string `xml:"parameter4,omitempty"`
-	Parameter5   string `xml:"parameter5,omitempty"`
-	Parameter6   string `xml:"parameter6,omitempty"`
-	Parameter7   string `xml:"parameter7,omitempty"`
-	Parameter8   string `xml:"parameter8,omitempty"`
-	Parameter9   string `xml:"parameter9,omitempty"`
-	Parameter10  string `xml:"parameter10,omitempty"`
-	Parameter11  string `xml:"parameter11,omitempty"`
+	ID              uint32               `xml:"id,omitempty"`
+	Name            string               `xml:"name,omitempty"`
+	Priority        PolicyScriptPriority `xml:"priority,omitempty"`
+	Parameter4      string               `xml:"parameter4,omitempty"`
+	Parameter5      string               `xml:"parameter5,omitempty"`
+	Parameter6      string               `xml:"parameter6,omitempty"`
+	Parameter7      string               `xml:"parameter7,omitempty"`
+	Parameter8      string               `xml:"parameter8,omitempty"`
+	Parameter9      string               `xml:"parameter9,omitempty"`
+	Parameter10     string               `xml:"parameter10,omitempty"`
+	Parameter11     string               `xml:"parameter11,omitempty"`
+	UnknownElements []RawXMLElement      `xml:",any"`
+}
+
+// parameter returns a pointer to the parameter slot n (4 to 11).
+func (s *PolicyScript) parameter(n int) *string {
+	switch n {
+	case 4:
+		return &s.Parameter4
+	case 5:
+		return &s.Parameter5
+	case 6:
+		return &s.Parameter6
+	case 7:
+		return &s.Parameter7
+	case 8:
+		return &s.Parameter8
+	case 9:
+		return &s.Parameter9
+	case 10:
+		return &s.Parameter10
+	case 11:
+		return &s.Parameter11
+	}
+	return nil
+}
+
+// Parameter returns the value of parameter n (4 to 11).
+func (s *PolicyScript) Parameter(n int) string {
+	if p := s.parameter(n); p != nil {
+		return *p
+	}
+	return ""
+}
+
+// SetParameter sets the value of parameter n (4 to 11).
+func (s *PolicyScript) SetParameter(n int, value string) error {
+	p := s.parameter(n)
+	if p == nil {
+		return fmt.Errorf("[jamf-pro-go] script parameter %d is out of range (4 to 11)", n)
+	}
+	*p = value
+	return nil
 }
 
 type PolicyPrinters struct {
-	Size                  uint32           `xml:"size,omitempty"`
-	LeaveExistingDefault  string           `xml:"leave_existing_default,omitempty"`
-	Printer               []*PolicyPrinter `xml:"printer,omitempty"`
+	Size                 uint32           `xml:"size,omitempty"`
+	LeaveExistingDefault string           `xml:"leave_existing_default,omitempty"`
+	Printer              []*PolicyPrinter `xml:"printer,omitempty"`
+	UnknownElements      []RawXMLElement  `xml:",any"`
 }
 
 type PolicyPrinter struct {
-	ID           uint32 `xml:"id,omitempty"`
-	Name         string `xml:"name,omitempty"`
-	Action       string `xml:"action,omitempty"` // [ install, uninstall ]
-	MakeDefault  string `xml:"make_default,omitempty"`
+	ID              uint32          `xml:"id,omitempty"`
+	Name            string          `xml:"name,omitempty"`
+	Action          PrinterAction   `xml:"action,omitempty"`
+	MakeDefault     *bool           `xml:"make_default,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyDockItems struct {
-	Size      uint32            `xml:"size,omitempty"`
-	DockItem  []*PolicyDockItem `xml:"dock_item,omitempty"`
+	Size            uint32            `xml:"size,omitempty"`
+	DockItem        []*PolicyDockItem `xml:"dock_item,omitempty"`
+	UnknownElements []RawXMLElement   `xml:",any"`
 }
 
 type PolicyDockItem struct {
-	ID      uint32 `xml:"id,omitempty"`
-	Name    string `xml:"name,omitempty"`
-	Action  string `xml:"action,omitempty"` // [ Add To Beginning, Add To End, Remove ]
+	ID              uint32          `xml:"id,omitempty"`
+	Name            string          `xml:"name,omitempty"`
+	Action          DockItemAction  `xml:"action,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyAccountMaintenance struct {
-	Accounts                 *PolicyAccounts                `xml:"accounts,omitempty"`
-	DirectoryBindings        *PolicyDirectoryBindings       `xml:"directory_bindings,omitempty"`
-	ManagementAccount        *PolicyManagementAccount       `xml:"management_account,omitempty"`
-	OpenFirmwareEfiPassword  *PolicyOpenFirmwareEfiPassword `xml:"open_firmware_efi_password,omitempty"`
+	Accounts                *PolicyAccounts                `xml:"accounts,omitempty"`
+	DirectoryBindings       *PolicyDirectoryBindings       `xml:"directory_bindings,omitempty"`
+	ManagementAccount       *PolicyManagementAccount       `xml:"management_account,omitempty"`
+	OpenFirmwareEfiPassword *PolicyOpenFirmwareEfiPassword `xml:"open_firmware_efi_password,omitempty"`
+	UnknownElements         []RawXMLElement                `xml:",any"`
 }
 
 type PolicyAccounts struct {
-	Size    uint32           `xml:"size,omitempty"`
-	Account []*PolicyAccount `xml:"account,omitempty"`
+	Size            uint32           `xml:"size,omitempty"`
+	Account         []*PolicyAccount `xml:"account,omitempty"`
+	UnknownElements []RawXMLElement  `xml:",any"`
 }
 
 type PolicyAccount struct {
-	Action                  string `xml:"action,omitempty"` // [ Create, Reset, Delete, DisableFileVault ]
-	UserName                string `xml:"user_name,omitempty"`
-	RealName                string `xml:"real_name,omitempty"`
-	Password                string `xml:"password,omitempty"`
-	ArchiveHomeDirectory    bool   `xml:"archive_home_directory,omitempty"`
-	ArchiveHomeDirectoryTo  string `xml:"archive_home_directory_to,omitempty"`
-	Home                    string `xml:"home,omitempty"`
-	Picture                 string `xml:"picture,omitempty"`
-	Admin                   bool   `xml:"admin,omitempty"`
-	FileVaultEnabled        bool   `xml:"filevault_enabled,omitempty"`
+	Action                 AccountAction   `xml:"action,omitempty"`
+	UserName               string          `xml:"username,omitempty"`
+	RealName               string          `xml:"realname,omitempty"`
+	Password               string          `xml:"password,omitempty"`
+	ArchiveHomeDirectory   *bool           `xml:"archive_home_directory,omitempty"`
+	ArchiveHomeDirectoryTo string          `xml:"archive_home_directory_to,omitempty"`
+	Home                   string          `xml:"home,omitempty"`
+	Hint                   string          `xml:"hint,omitempty"`
+	Picture                string          `xml:"picture,omitempty"`
+	Admin                  *bool           `xml:"admin,omitempty"`
+	FileVaultEnabled       *bool           `xml:"filevault_enabled,omitempty"`
+	UnknownElements        []RawXMLElement `xml:",any"`
 }
 
 type PolicyDirectoryBindings struct {
-	Size     uint32                    `xml:"size,omitempty"`
-	Binding  []*PolicyDirectoryBinding `xml:"binding,omitempty"`
+	Size            uint32                    `xml:"size,omitempty"`
+	Binding         []*PolicyDirectoryBinding `xml:"binding,omitempty"`
+	UnknownElements []RawXMLElement           `xml:",any"`
 }
 
 type PolicyDirectoryBinding struct {
-	ID    uint32 `xml:"id,omitempty"`
-	Name  string `xml:"name,omitempty"`
+	ID              uint32          `xml:"id,omitempty"`
+	Name            string          `xml:"name,omitempty"`
+	UnknownElements []RawXMLElement `xml:",any"`
 }
 
 type PolicyManagementAccount struct {
-	Action                 string `xml:"action,omitempty"` // [ specified, random, reset, fileVaultEnable, fileVaultDisable ]
-	ManagedPassword        string `xml:"managed_password,omitempty"`
-	ManagedPasswordLength  uint32 `xml:"managed_password_length,omitempty"`
+	Action                ManagementAccountAction `xml:"action,omitempty"`
+	ManagedPassword       string                  `xml:"managed_password,omitempty"`
+	ManagedPasswordLength uint32                  `xml:"managed_password_length,omitempty"`
+	UnknownElements       []RawXMLElement         `xml:",any"`
 }
 
 type PolicyOpenFirmwareEfiPassword struct {
-	OfMode      string `xml:"of_mode,omitempty"` // [ command, none ]
-	OfPassword  string `xml:"of_password,omitempty"`
+	OfMode           string          `xml:"of_mode,omitempty"` // [ command, none ]
+	OfPassword       string          `xml:"of_password,omitempty"`
+	OfPasswordSHA256 string          `xml:"of_password_sha256,omitempty"`
+	UnknownElements  []RawXMLElement `xml:",any"`
 }
 
 type PolicyRebootSettings struct {
-	Message                      string `xml:"message"`
-	StartupDisk                  string `xml:"startup_disk"`
-	SpecifyStartup               string `xml:"specify_startup"`
-	NoUserLoggedIn               string `xml:"no_user_logged_in"`
-	UserLoggedIn                 string `xml:"user_logged_in"`
-	MinutesUntilReboot           int32  `xml:"minutes_until_reboot"`
-	StartRebootTimerImmediately  bool  `xml:"start_reboot_timer_immediately"`
-	FileVaultReboot              bool   `xml:"file_value_2_reboot"`
+	Message                     string          `xml:"message"`
+	StartupDisk                 string          `xml:"startup_disk"` // [ Current Startup Disk, Specify Local Startup Disk, Currently Selected Startup Disk (No Bless), NetBoot, macOS Installer ]
+	SpecifyStartup              string          `xml:"specify_startup"`
+	NoUserLoggedIn              string          `xml:"no_user_logged_in"` // [ Restart if a package or update requires it, Restart immediately, Do not restart ]
+	UserLoggedIn                string          `xml:"user_logged_in"`    // [ Restart if a package or update requires it, Restart immediately, Restart, Do not restart ]
+	MinutesUntilReboot          int32           `xml:"minutes_until_reboot"`
+	StartRebootTimerImmediately *bool           `xml:"start_reboot_timer_immediately,omitempty"`
+	FileVaultReboot             *bool           `xml:"file_vault_2_reboot,omitempty"`
+	UnknownElements             []RawXMLElement `xml:",any"`
 }
 
 type PolicyMaintenance struct {
-	Recon                     bool `xml:"recon,omitempty"`
-	ResetName                 bool `xml:"reset_name,omitempty"`
-	InstallAllCachedPackages  bool `xml:"install_all_cached_packages,omitempty"`
-	Heal                      bool `xml:"heal,omitempty"`
-	Prebindings               bool `xml:"prebindings,omitempty"`
-	Permissions               bool `xml:"permissions,omitempty"`
-	Byhost                    bool `xml:"byhost,omitempty"`
-	SystemCache               bool `xml:"system_cache,omitempty"`
-	UserCache                 bool `xml:"user_cache,omitempty"`
-	Verify                    bool `xml:"verify,omitempty"`
+	Recon                    *bool           `xml:"recon,omitempty"`
+	ResetName                *bool           `xml:"reset_name,omitempty"`
+	InstallAllCachedPackages *bool           `xml:"install_all_cached_packages,omitempty"`
+	Heal                     *bool           `xml:"heal,omitempty"`
+	Prebindings              *bool           `xml:"prebindings,omitempty"`
+	Permissions              *bool           `xml:"permissions,omitempty"`
+	Byhost                   *bool           `xml:"byhost,omitempty"`
+	SystemCache              *bool           `xml:"system_cache,omitempty"`
+	UserCache                *bool           `xml:"user_cache,omitempty"`
+	Verify                   *bool           `xml:"verify,omitempty"`
+	UnknownElements          []RawXMLElement `xml:",any"`
 }
 
 type PolicyFilesProcesses struct {
-	SearchByPath          string `xml:"search_by_path,omitempty"`
-	DeleteFile            bool   `xml:"delete_file,omitempty"`
-	LocateFile            string `xml:"locate_file,omitempty"`
-	UpdateLocateDatabase  bool   `xml:"update_locate_database,omitempty"`
-	SpotlightSearch       string `xml:"spotlight_search,omitempty"`
-	SearchForProcess      string `xml:"search_for_process,omitempty"`
-	KillProcess           bool   `xml:"kill_process,omitempty"`
-	RunCommand            string `xml:"run_command,omitempty"`
+	SearchByPath         string          `xml:"search_by_path,omitempty"`
+	DeleteFile           *bool           `xml:"delete_file,omitempty"`
+	LocateFile           string          `xml:"locate_file,omitempty"`
+	UpdateLocateDatabase *bool           `xml:"update_locate_database,omitempty"`
+	SpotlightSearch      string          `xml:"spotlight_search,omitempty"`
+	SearchForProcess     string          `xml:"search_for_process,omitempty"`
+	KillProcess          *bool           `xml:"kill_process,omitempty"`
+	RunCommand           string          `xml:"run_command,omitempty"`
+	UnknownElements      []RawXMLElement `xml:",any"`
 }
 
 type PolicyUserInteraction struct {
-	MessageStart           string `xml:"message_start,omitempty"`
-	AllowUsersToDefer      bool   `xml:"allow_users_to_defer,omitempty"`
-	AllowDeferralUntilUtc  string `xml:"allow_deferral_until_utc,omitempty"`
-	AllowDeferralMinutes   uint32 `xml:"allow_deferral_minutes,omitempty"`
-	MessageFinish          string `xml:"message_finish,omitempty"`
+	MessageStart          string          `xml:"message_start,omitempty"`
+	AllowUsersToDefer     *bool           `xml:"allow_users_to_defer,omitempty"`
+	AllowDeferralUntilUtc string          `xml:"allow_deferral_until_utc,omitempty"`
+	AllowDeferralMinutes  uint32          `xml:"allow_deferral_minutes,omitempty"`
+	MessageFinish         string          `xml:"message_finish,omitempty"`
+	UnknownElements       []RawXMLElement `xml:",any"`
 }
 
 type PolicyDiskEncryption struct {
-	Action                                  string `xml:"action,omitempty"` // [ apply, remediate ]
-	DiskEncryptionConfigurationID           uint32 `xml:"disk_encryption_configuration_id,omitempty"`
-	AuthRestart                             bool   `xml:"auth_restart,omitempty"` // [ Individual, Institutional, Individual And Institutional ]
-	RemediateKeyType                        string `xml:"remediate_key_type,omitempty"`
-	RemediateDiskEncryptionConfigurationID  uint32 `xml:"remediate_disk_encryption_configuration_id,omitempty"`
+	Action                                 DiskEncryptionAction `xml:"action,omitempty"`
+	DiskEncryptionConfigurationID          uint32               `xml:"disk_encryption_configuration_id,omitempty"`
+	AuthRestart                            *bool                `xml:"auth_restart,omitempty"`
+	RemediateKeyType                       string               `xml:"remediate_key_type,omitempty"` // [ Individual, Institutional, Individual And Institutional ]
+	RemediateDiskEncryptionConfigurationID uint32               `xml:"remediate_disk_encryption_configuration_id,omitempty"`
+	UnknownElements                        []RawXMLElement      `xml:",any"`
 }
 
 type GetPoliciesResult struct {
@@ -425,23 +559,21 @@ func (c *Client) GetPolicy(policyID uint32) (*Policy, error) {
 	return &result, nil
 }
 
-type CreatePolicyParams struct {
-	XMLName               xml.Name                    `xml:"policy,omitempty"`
-	General               *PolicyGeneral              `xml:"general,omitempty"`
-	Scope                 *PolicyScope                `xml:"scope,omitempty"`
-	SelfService           *PolicySelfService          `xml:"self_service,omitempty"`
-	PackageConfiguration  *PolicyPackageConfiguration `xml:"package_configuration,omitempty"`
-	Scripts               *PolicyScripts              `xml:"scripts,omitempty"`
-	Printers              *PolicyPrinters             `xml:"printers,omitempty"`
-	DockItems             *PolicyDockItems            `xml:"dock_items,omitempty"`
-	AccountMaintenance    *PolicyAccountMaintenance   `xml:"account_maintenance,omitempty"`
-	RebootSettings        *PolicyRebootSettings       `xml:"reboot,omitempty"` // Current Startup Disk, Specify Local Startup Disk, Currently Selected Startup Disk (No Bless), NetBoot, macOS Installer
-	Maintenance           *PolicyMaintenance          `xml:"maintenance,omitempty"`
-	FilesProcesses        *PolicyFilesProcesses       `xml:"files_processes,omitempty"`
-	UserInteraction       *PolicyUserInteraction      `xml:"user_interaction,omitempty"`
-	DiskEncryption        *PolicyDiskEncryption       `xml:"disk_encryption,omitempty"`
+func (c *Client) GetPolicyByName(name string) (*Policy, error) {
+	var result Policy
+
+	err := c.call(path.Join(APIPathPolices, "name", escapePathSegment(name)), http.MethodGet,
+		APIVersionPolicies, nil, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
 }
 
+// CreatePolicyParams is the request body of CreatePolicy.
+type CreatePolicyParams = Policy
+
 type CreatePolicyResult struct {
 	XMLName	xml.Name	`xml:"policy,omitempty"`
 	ID		uint32		`xml:"id,omitempty"`
@@ -450,6 +582,14 @@ type CreatePolicyResult struct {
 func (c *Client) CreatePolicy (params *CreatePolicyParams) (*CreatePolicyResult, error) {
 	var result CreatePolicyResult
 
+	if c.config.ValidatePolicies {
+		if err := params.Validate(); err != nil {
+			return nil, err
+		}
+	} else if err := validateEnums(params); err != nil {
+		return nil, err
+	}
+
 	err := c.call(path.Join(APIPathPolices, "id", "0"), http.MethodPost,
 		APIVersionPolicies, nil, params, &result)
 	if err != nil {
@@ -460,22 +600,9 @@ func (c *Client) CreatePolicy (params *CreatePolicyParams) (*CreatePolicyResult,
 }
 
 
-type UpdatePolicyParams struct {
-	XMLName               xml.Name                    `xml:"policy,omitempty"`
-	General               *PolicyGeneral              `xml:"general,omitempty"`
-	Scope                 *PolicyScope                `xml:"scope,omitempty"`
-	SelfService           *PolicySelfService          `xml:"self_service,omitempty"`
-	PackageConfiguration  *PolicyPackageConfiguration `xml:"package_configuration,omitempty"`
-	Scripts               *PolicyScripts              `xml:"scripts,omitempty"`
-	Printers              *PolicyPrinters             `xml:"printers,omitempty"`
-	DockItems             *PolicyDockItems            `xml:"dock_items,omitempty"`
-	AccountMaintenance    *PolicyAccountMaintenance   `xml:"account_maintenance,omitempty"`
-	RebootSettings        *PolicyRebootSettings       `xml:"reboot,omitempty"`
-	Maintenance           *PolicyMaintenance          `xml:"maintenance,omitempty"`
-	FilesProcesses        *PolicyFilesProcesses       `xml:"files_processes,omitempty"`
-	UserInteraction       *PolicyUserInteraction      `xml:"user_interaction,omitempty"`
-	DiskEncryption        *PolicyDiskEncryption       `xml:"disk_encryption,omitempty"`
-}
+// UpdatePolicyParams is the request body of UpdatePolicy.
+// Sections left nil are not changed.
+type UpdatePolicyParams = Policy
 
 type UpdatePolicyResult struct {
 	XMLName  xml.Name `xml:"policy,omitempty"`
@@ -485,6 +612,14 @@ type UpdatePolicyResult struct {
 func (c *Client) UpdatePolicy (policyID uint32, params *UpdatePolicyParams) (*UpdatePolicyResult, error) {
 	var result UpdatePolicyResult
 
+	if c.config.ValidatePolicies {
+		if err := params.ValidateUpdate(); err != nil {
+			return nil, err
+		}
+	} else if err := validateEnums(params); err != nil {
+		return nil, err
+	}
+
 	err := c.call(path.Join(APIPathPolices, "id", fmt.Sprint(policyID)), http.MethodPut,
 		APIVersionPolicies, nil, params, &result)
 	if err != nil {
@@ -0,0 +1,101 @@
+package jamf_pro_go
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func readPolicyFixture(t *testing.T) *Policy {
+	t.Helper()
+	b, err := ioutil.ReadFile("testdata/policy.xml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var p Policy
+	if err := xml.Unmarshal(b, &p); err != nil {
+		t.Fatal(err)
+	}
+	return &p
+}
+
+// checkNoUnknownElements fails for every element of v the model did not
+// decode into a field.
+func checkNoUnknownElements(t *testing.T, path string, v reflect.Value) {
+	t.Helper()
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			checkNoUnknownElements(t, path, v.Elem())
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			checkNoUnknownElements(t, path, v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			f := v.Type().Field(i)
+			if f.Name == "UnknownElements" {
+				for _, e := range v.Field(i).Interface().([]RawXMLElement) {
+					t.Errorf("%s: unknown element <%s>", path, e.XMLName.Local)
+				}
+				continue
+			}
+			checkNoUnknownElements(t, path+"."+f.Name, v.Field(i))
+		}
+	}
+}
+
+func TestPolicyFixtureDecodesCompletely(t *testing.T) {
+	p := readPolicyFixture(t)
+	checkNoUnknownElements(t, "policy", reflect.ValueOf(p))
+}
+
+func TestPolicyFixtureRoundTrip(t *testing.T) {
+	p := readPolicyFixture(t)
+
+	b, err := xml.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var again Policy
+	if err := xml.Unmarshal(b, &again); err != nil {
+		t.Fatal(err)
+	}
+
+	if d := DiffPolicies(p, &again); !d.Empty() {
+		t.Errorf("round trip changed the policy:\n%s", d)
+	}
+}
+
+func TestPolicyFixtureRenamedElements(t *testing.T) {
+	p := readPolicyFixture(t)
+
+	if p.Scope == nil || p.Scope.Buildings == nil || len(p.Scope.Buildings.Building) != 1 {
+		t.Fatalf("scope buildings not decoded: %+v", p.Scope)
+	}
+	if b := p.Scope.Buildings.Building[0]; b.ID != 1 || b.Name != "Head Office" {
+		t.Errorf("building = %+v, want ID 1 named Head Office", b)
+	}
+	if p.RebootSettings == nil || p.RebootSettings.FileVaultReboot == nil {
+		t.Fatal("reboot.file_vault_2_reboot not decoded")
+	}
+	if BoolValue(p.RebootSettings.FileVaultReboot) {
+		t.Error("reboot.file_vault_2_reboot = true, want false")
+	}
+
+	b, err := xml.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"<buildings><building><id>1</id><name>Head Office</name></building></buildings>",
+		"<file_vault_2_reboot>false</file_vault_2_reboot>",
+	} {
+		if !strings.Contains(string(b), want) {
+			t.Errorf("encoded policy does not contain %s", want)
+		}
+	}
+}
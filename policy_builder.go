@@ -0,0 +1,244 @@
+package jamf_pro_go
+
+import (
+	"errors"
+	"fmt"
+)
+
+type PolicyEvent string
+
+const (
+	PolicyEventCheckin             PolicyEvent = "checkin"
+	PolicyEventEnrollmentComplete  PolicyEvent = "enrollment_complete"
+	PolicyEventLogin               PolicyEvent = "login"
+	PolicyEventLogout              PolicyEvent = "logout"
+	PolicyEventNetworkStateChanged PolicyEvent = "network_state_changed"
+	PolicyEventStartup             PolicyEvent = "startup"
+)
+
+// PolicyBuilder builds a Policy step by step.
+//
+//	policy, err := jamf.NewPolicy("Install Chrome").
+//		Trigger(jamf.PolicyEventCheckin).
+//		Frequency(jamf.PolicyFrequencyOncePerComputer).
+//		AddScript(41, jamf.PolicyScriptPriorityAfter, "stable").
+//		ScopeToGroup(7).
+//		Build()
+type PolicyBuilder struct {
+	policy *Policy
+	errs   []ValidationProblem
+}
+
+// NewPolicy starts building a policy with the given name.
+func NewPolicy(name string) *PolicyBuilder {
+	return &PolicyBuilder{
+		policy: &Policy{
+			General: &PolicyGeneral{Name: name},
+		},
+	}
+}
+
+func (b *PolicyBuilder) Enabled(enabled bool) *PolicyBuilder {
+	b.policy.General.Enabled = Bool(enabled)
+	return b
+}
+
+func (b *PolicyBuilder) Frequency(frequency PolicyFrequency) *PolicyBuilder {
+	b.policy.General.Frequency = frequency
+	return b
+}
+
+func (b *PolicyBuilder) Category(id int32, name string) *PolicyBuilder {
+	b.policy.General.Category = &PolicyCategory{ID: id, Name: name}
+	return b
+}
+
+// Trigger runs the policy on the given events.
+func (b *PolicyBuilder) Trigger(events ...PolicyEvent) *PolicyBuilder {
+	g := b.policy.General
+	g.Trigger = PolicyTriggerEvent
+	for _, e := range events {
+		switch e {
+		case PolicyEventCheckin:
+			g.TriggerCheckin = Bool(true)
+		case PolicyEventEnrollmentComplete:
+			g.TriggerEnrollmentComplete = Bool(true)
+		case PolicyEventLogin:
+			g.TriggerLogin = Bool(true)
+		case PolicyEventLogout:
+			g.TriggerLogout = Bool(true)
+		case PolicyEventNetworkStateChanged:
+			g.TriggerNetworkStateChanged = Bool(true)
+		case PolicyEventStartup:
+			g.TriggerStartup = Bool(true)
+		default:
+			b.fail("general.trigger", "unknown event %q", e)
+		}
+	}
+	return b
+}
+
+// CustomTrigger runs the policy on the custom event name,
+// e.g. `jamf policy -event <name>`.
+func (b *PolicyBuilder) CustomTrigger(name string) *PolicyBuilder {
+	b.policy.General.Trigger = PolicyTriggerEvent
+	b.policy.General.TriggerOther = name
+	return b
+}
+
+// AddScript runs the script with the given parameters, which fill
+// Parameter4 to Parameter11 in order.
+func (b *PolicyBuilder) AddScript(id uint32, priority PolicyScriptPriority, params ...string) *PolicyBuilder {
+	p := b.policy
+	if p.Scripts == nil {
+		p.Scripts = &PolicyScripts{}
+	}
+	script := &PolicyScript{ID: id, Priority: priority}
+	if len(params) > 8 {
+		b.fail(fmt.Sprintf("scripts[%d]", len(p.Scripts.PolicyScript)), "too many parameters (%d), at most 8", len(params))
+		params = params[:8]
+	}
+	for i, v := range params {
+		script.SetParameter(i+4, v)
+	}
+	p.Scripts.PolicyScript = append(p.Scripts.PolicyScript, script)
+	return b
+}
+
+func (b *PolicyBuilder) AddPackage(id uint32, action PackageAction) *PolicyBuilder {
+	p := b.policy
+	if p.PackageConfiguration == nil {
+		p.PackageConfiguration = &PolicyPackageConfiguration{}
+	}
+	if p.PackageConfiguration.Packages == nil {
+		p.PackageConfiguration.Packages = &PolicyPackages{}
+	}
+	packages := p.PackageConfiguration.Packages
+	packages.Package = append(packages.Package, &PolicyPackage{ID: id, Action: action})
+	return b
+}
+
+func (b *PolicyBuilder) AddPrinter(id uint32, action PrinterAction) *PolicyBuilder {
+	p := b.policy
+	if p.Printers == nil {
+		p.Printers = &PolicyPrinters{}
+	}
+	p.Printers.Printer = append(p.Printers.Printer, &PolicyPrinter{ID: id, Action: action})
+	return b
+}
+
+func (b *PolicyBuilder) AddDockItem(id uint32, action DockItemAction) *PolicyBuilder {
+	p := b.policy
+	if p.DockItems == nil {
+		p.DockItems = &PolicyDockItems{}
+	}
+	p.DockItems.DockItem = append(p.DockItems.DockItem, &PolicyDockItem{ID: id, Action: action})
+	return b
+}
+
+func (b *PolicyBuilder) ScopeToAllComputers() *PolicyBuilder {
+	b.scope().AllComputers = Bool(true)
+	return b
+}
+
+func (b *PolicyBuilder) ScopeToComputer(id uint32) *PolicyBuilder {
+	s := b.scope()
+	if s.Computers == nil {
+		s.Computers = &PolicyScopeComputers{}
+	}
+	s.Computers.Computer = append(s.Computers.Computer, &PolicyScopeComputer{ID: id})
+	return b
+}
+
+func (b *PolicyBuilder) ScopeToGroup(id uint32) *PolicyBuilder {
+	s := b.scope()
+	if s.ComputerGroups == nil {
+		s.ComputerGroups = &PolicyScopeComputerGroups{}
+	}
+	s.ComputerGroups.ComputerGroup = append(s.ComputerGroups.ComputerGroup, &PolicyScopeComputerGroup{ID: id})
+	return b
+}
+
+func (b *PolicyBuilder) ExcludeComputer(id uint32) *PolicyBuilder {
+	e := b.exclusions()
+	if e.Computers == nil {
+		e.Computers = &PolicyScopeComputers{}
+	}
+	e.Computers.Computer = append(e.Computers.Computer, &PolicyScopeComputer{ID: id})
+	return b
+}
+
+func (b *PolicyBuilder) ExcludeGroup(id uint32) *PolicyBuilder {
+	e := b.exclusions()
+	if e.ComputerGroups == nil {
+		e.ComputerGroups = &PolicyScopeComputerGroups{}
+	}
+	e.ComputerGroups.ComputerGroup = append(e.ComputerGroups.ComputerGroup, &PolicyScopeComputerGroup{ID: id})
+	return b
+}
+
+// SelfService makes the policy available in Self Service under the given name.
+func (b *PolicyBuilder) SelfService(displayName, description string) *PolicyBuilder {
+	b.policy.SelfService = &PolicySelfService{
+		UseForSelfService:      Bool(true),
+		SelfServiceDisplayName: displayName,
+		SelfServiceDescription: description,
+	}
+	return b
+}
+
+// Reboot restarts the computer after the policy has run.
+func (b *PolicyBuilder) Reboot(message string, minutes int32) *PolicyBuilder {
+	b.policy.RebootSettings = &PolicyRebootSettings{
+		Message:            message,
+		StartupDisk:        "Current Startup Disk",
+		NoUserLoggedIn:     "Restart immediately",
+		UserLoggedIn:       "Restart",
+		MinutesUntilReboot: minutes,
+	}
+	return b
+}
+
+// UpdateInventory runs recon at the end of the policy.
+func (b *PolicyBuilder) UpdateInventory() *PolicyBuilder {
+	if b.policy.Maintenance == nil {
+		b.policy.Maintenance = &PolicyMaintenance{}
+	}
+	b.policy.Maintenance.Recon = Bool(true)
+	return b
+}
+
+// Build returns a copy of the validated policy, so that the builder can be
+// reused without changing it.
+func (b *PolicyBuilder) Build() (*Policy, error) {
+	v := &ValidationError{Problems: append([]ValidationProblem(nil), b.errs...)}
+	var invalid *ValidationError
+	if err := b.policy.Validate(); errors.As(err, &invalid) {
+		v.Problems = append(v.Problems, invalid.Problems...)
+	} else if err != nil {
+		return nil, err
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	return b.policy.Copy()
+}
+
+func (b *PolicyBuilder) scope() *PolicyScope {
+	if b.policy.Scope == nil {
+		b.policy.Scope = &PolicyScope{}
+	}
+	return b.policy.Scope
+}
+
+func (b *PolicyBuilder) exclusions() *PolicyScopeExclusions {
+	s := b.scope()
+	if s.Exclusions == nil {
+		s.Exclusions = &PolicyScopeExclusions{}
+	}
+	return s.Exclusions
+}
+
+func (b *PolicyBuilder) fail(field, format string, a ...interface{}) {
+	b.errs = append(b.errs, ValidationProblem{Field: field, Message: fmt.Sprintf(format, a...)})
+}
@@ -0,0 +1,76 @@
+package jamf_pro_go
+
+import (
+	"encoding/xml"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestPolicyBuilder(t *testing.T) {
+	b := NewPolicy("Install Chrome").
+		Trigger(PolicyEventCheckin, PolicyEventStartup).
+		Frequency(PolicyFrequencyOncePerComputer).
+		AddScript(41, PolicyScriptPriorityAfter, "stable").
+		AddPackage(7, PackageActionInstall).
+		ScopeToGroup(3).
+		ExcludeComputer(9).
+		UpdateInventory()
+	policy, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := xml.Marshal(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `<policy>` +
+		`<general><name>Install Chrome</name><trigger>EVENT</trigger><trigger_checkin>true</trigger_checkin>` +
+		`<trigger_startup>true</trigger_startup><frequency>Once per computer</frequency></general>` +
+		`<scope><computer_groups><computer_group><id>3</id></computer_group></computer_groups>` +
+		`<exclusions><computers><computer><id>9</id></computer></computers></exclusions></scope>` +
+		`<package_configuration><packages><size>1</size><package><id>7</id><action>Install</action></package></packages></package_configuration>` +
+		`<scripts><size>1</size><script><id>41</id><priority>After</priority><parameter4>stable</parameter4></script></scripts>` +
+		`<maintenance><recon>true</recon></maintenance>` +
+		`</policy>`
+	if string(got) != want {
+		t.Errorf("built policy:\n%s\nwant\n%s", got, want)
+	}
+
+	// the built policy does not change with the builder
+	b.Enabled(false).AddScript(42, PolicyScriptPriorityBefore)
+	if policy.General.Enabled != nil || len(policy.Scripts.PolicyScript) != 1 {
+		t.Errorf("building further changed the built policy: %+v", policy.General)
+	}
+}
+
+func TestPolicyBuilderErrors(t *testing.T) {
+	_, err := NewPolicy("").
+		Trigger("wake").
+		AddScript(41, "during", "1", "2", "3", "4", "5", "6", "7", "8", "9").
+		Reboot("", 5).
+		Build()
+
+	var v *ValidationError
+	if !errors.As(err, &v) {
+		t.Fatalf("Build() error = %v, want a *ValidationError", err)
+	}
+	var got []string
+	for _, p := range v.Problems {
+		got = append(got, p.Field)
+	}
+	want := []string{
+		// collected by the builder
+		"general.trigger",
+		"scripts[0]",
+		// found by Validate
+		"general.name",
+		"general.trigger_other",
+		"scripts[0].priority",
+		"reboot.message",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("problems in %v, want %v", got, want)
+	}
+}
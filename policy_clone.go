@@ -0,0 +1,46 @@
+package jamf_pro_go
+
+import "errors"
+
+type ClonePolicyOpts struct {
+	// Name of the new policy (required).
+	Name string
+	// Scope replaces the scope of the source policy if set.
+	Scope *PolicyScope
+	// Enabled enables the new policy. By default the copy is disabled.
+	Enabled bool
+}
+
+// ClonePolicy creates a copy of the policy and returns the ID of the new
+// policy. Server-assigned identifiers are removed from the copy.
+func (c *Client) ClonePolicy(policyID uint32, opts ClonePolicyOpts) (uint32, error) {
+	if len(opts.Name) == 0 {
+		return 0, errors.New("[Err] missing policy name")
+	}
+
+	source, err := c.GetPolicy(policyID)
+	if err != nil {
+		return 0, err
+	}
+
+	policy, err := source.WithoutID()
+	if err != nil {
+		return 0, err
+	}
+	if policy.General == nil {
+		policy.General = &PolicyGeneral{}
+	}
+	policy.General.Name = opts.Name
+	policy.General.Enabled = Bool(opts.Enabled)
+	if opts.Scope != nil {
+		policy.Scope = opts.Scope
+	}
+
+	result, err := c.CreatePolicy(policy)
+	if err != nil {
+		return 0, err
+	}
+	c.logf("[jamf-pro-go] Policy (ID: %d) is cloned to policy (ID: %d)", policyID, result.ID)
+
+	return result.ID, nil
+}
@@ -0,0 +1,75 @@
+package jamf_pro_go
+
+import (
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const clonedPolicyXML = `<policy>
+	<general><id>5</id><name>Install</name><enabled>true</enabled><frequency>Ongoing</frequency></general>
+	<scope><all_computers>false</all_computers><computers><computer><id>7</id><name>mac</name></computer></computers></scope>
+</policy>`
+
+func TestClonePolicy(t *testing.T) {
+	var created []*Policy
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /JSSResource/policies/id/5":
+			w.Write([]byte(clonedPolicyXML))
+		case "POST /JSSResource/policies/id/0":
+			body, _ := ioutil.ReadAll(r.Body)
+			var p Policy
+			if err := xml.Unmarshal(body, &p); err != nil {
+				t.Errorf("decode %s: %v", body, err)
+			}
+			created = append(created, &p)
+			w.Write([]byte("<policy><id>9</id></policy>"))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	c := NewClient(&Config{BaseURL: srv.URL})
+
+	for _, tt := range []struct {
+		name      string
+		opts      ClonePolicyOpts
+		enabled   bool
+		computers int
+	}{
+		{"defaults", ClonePolicyOpts{Name: "Install copy"}, false, 1},
+		{"enabled", ClonePolicyOpts{Name: "Install copy", Enabled: true}, true, 1},
+		{"scope dropped", ClonePolicyOpts{Name: "Install copy", Scope: &PolicyScope{}}, false, 0},
+	} {
+		created = nil
+		id, err := c.ClonePolicy(5, tt.opts)
+		if err != nil || id != 9 {
+			t.Errorf("%s: ClonePolicy() = %d, %v", tt.name, id, err)
+			continue
+		}
+		if len(created) != 1 {
+			t.Errorf("%s: %d policies created, want 1", tt.name, len(created))
+			continue
+		}
+
+		g := created[0].General
+		if g.ID != 0 || g.Name != "Install copy" || BoolValue(g.Enabled) != tt.enabled || g.Frequency != PolicyFrequencyOngoing {
+			t.Errorf("%s: general = %+v", tt.name, g)
+		}
+		computers := 0
+		if s := created[0].Scope; s != nil && s.Computers != nil {
+			computers = len(s.Computers.Computer)
+		}
+		if computers != tt.computers {
+			t.Errorf("%s: %d computers in scope, want %d", tt.name, computers, tt.computers)
+		}
+	}
+
+	if _, err := c.ClonePolicy(5, ClonePolicyOpts{}); err == nil {
+		t.Error("ClonePolicy() without a name succeeded")
+	}
+}
@@ -0,0 +1,121 @@
+package jamf_pro_go
+
+import (
+	"encoding/xml"
+	"reflect"
+	"sync"
+)
+
+// Classic list wrappers
+//
+// The size element of a classic list is computed from the length of the list
+// when marshalling, and recomputed the same way when unmarshalling, so it
+// never has to be kept in sync by hand. A list wrapper is a struct with a
+// Size field and one list; its MarshalXML and UnmarshalXML call
+// marshalSizedList and unmarshalSizedList.
+
+func (l PolicyPackages) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalSizedList(e, start, l)
+}
+
+func (l *PolicyPackages) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalSizedList(d, start, l)
+}
+
+func (l PolicyScripts) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalSizedList(e, start, l)
+}
+
+func (l *PolicyScripts) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalSizedList(d, start, l)
+}
+
+func (l PolicyPrinters) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalSizedList(e, start, l)
+}
+
+func (l *PolicyPrinters) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalSizedList(d, start, l)
+}
+
+func (l PolicyDockItems) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalSizedList(e, start, l)
+}
+
+func (l *PolicyDockItems) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalSizedList(d, start, l)
+}
+
+func (l PolicyAccounts) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalSizedList(e, start, l)
+}
+
+func (l *PolicyAccounts) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalSizedList(d, start, l)
+}
+
+func (l PolicyDirectoryBindings) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalSizedList(e, start, l)
+}
+
+func (l *PolicyDirectoryBindings) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalSizedList(d, start, l)
+}
+
+func (l GetPoliciesResult) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalSizedList(e, start, l)
+}
+
+func (l *GetPoliciesResult) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	return unmarshalSizedList(d, start, l)
+}
+
+// marshalSizedList encodes the list wrapper l with its size set.
+func marshalSizedList(e *xml.Encoder, start xml.StartElement, l interface{}) error {
+	v := reflect.ValueOf(l)
+	plain := reflect.New(plainListType(v.Type())).Elem()
+	plain.Set(v.Convert(plain.Type()))
+	setListSize(plain)
+	return e.EncodeElement(plain.Interface(), start)
+}
+
+// unmarshalSizedList decodes into the list wrapper l, a pointer, and
+// recomputes its size.
+func unmarshalSizedList(d *xml.Decoder, start xml.StartElement, l interface{}) error {
+	v := reflect.ValueOf(l).Elem()
+	plain := reflect.New(plainListType(v.Type()))
+	if err := d.DecodeElement(plain.Interface(), &start); err != nil {
+		return err
+	}
+	v.Set(plain.Elem().Convert(v.Type()))
+	setListSize(v)
+	return nil
+}
+
+var plainListTypes sync.Map // reflect.Type -> reflect.Type
+
+// plainListType returns a struct type with the fields of t but without its
+// methods, so that encoding it does not call MarshalXML again.
+func plainListType(t reflect.Type) reflect.Type {
+	if plain, ok := plainListTypes.Load(t); ok {
+		return plain.(reflect.Type)
+	}
+	fields := make([]reflect.StructField, t.NumField())
+	for i := range fields {
+		fields[i] = t.Field(i)
+	}
+	plain, _ := plainListTypes.LoadOrStore(t, reflect.StructOf(fields))
+	return plain.(reflect.Type)
+}
+
+// setListSize sets the Size field of a list wrapper to the length of its list.
+func setListSize(v reflect.Value) {
+	size := v.FieldByName("Size")
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if f.Kind() == reflect.Slice && f.Type() != rawXMLElementsType {
+			size.SetUint(uint64(f.Len()))
+			return
+		}
+	}
+}
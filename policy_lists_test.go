@@ -0,0 +1,64 @@
+package jamf_pro_go
+
+import (
+	"encoding/xml"
+	"testing"
+)
+
+func TestSizedListMarshal(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		list interface{}
+		want string
+	}{
+		{
+			"scripts",
+			&PolicyScripts{Size: 7, PolicyScript: []*PolicyScript{{ID: 1}, {ID: 2}}},
+			`<PolicyScripts><size>2</size><script><id>1</id></script><script><id>2</id></script></PolicyScripts>`,
+		},
+		{
+			"no scripts",
+			&PolicyScripts{Size: 7},
+			`<PolicyScripts></PolicyScripts>`,
+		},
+		{
+			"group computers",
+			&ComputerGroupComputers{Computer: []*ComputerGroupComputer{{ID: 3}}},
+			`<ComputerGroupComputers><size>1</size><computer><id>3</id></computer></ComputerGroupComputers>`,
+		},
+		{
+			"policies",
+			&GetPoliciesResult{Size: 1, Policy: []PolicyOverview{{ID: 1}, {ID: 2}}},
+			`<GetPoliciesResult><size>2</size><policy><id>1</id></policy><policy><id>2</id></policy></GetPoliciesResult>`,
+		},
+	} {
+		got, err := xml.Marshal(tt.list)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if string(got) != tt.want {
+			t.Errorf("%s: got %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestSizedListUnmarshal(t *testing.T) {
+	var scripts PolicyScripts
+	in := `<scripts><size>9</size><script><id>1</id></script><extra>kept</extra></scripts>`
+	if err := xml.Unmarshal([]byte(in), &scripts); err != nil {
+		t.Fatal(err)
+	}
+	if scripts.Size != 1 || len(scripts.PolicyScript) != 1 || len(scripts.UnknownElements) != 1 {
+		t.Errorf("got size %d, %d scripts and %d unknown elements, want 1 each",
+			scripts.Size, len(scripts.PolicyScript), len(scripts.UnknownElements))
+	}
+
+	var criteria ComputerGroupCriteria
+	if err := xml.Unmarshal([]byte(`<criteria><size>3</size></criteria>`), &criteria); err != nil {
+		t.Fatal(err)
+	}
+	if criteria.Size != 0 {
+		t.Errorf("got size %d for no criteria, want 0", criteria.Size)
+	}
+}
@@ -0,0 +1,105 @@
+package jamf_pro_go
+
+import (
+	"bytes"
+	"encoding/xml"
+)
+
+type PatchPolicyOpts struct {
+	// CheckConcurrentModification re-reads the policy right before writing it
+	// and fails with ErrPolicyModified if it differs from the policy that was
+	// passed to the mutation function.
+	CheckConcurrentModification bool
+}
+
+// PatchPolicy reads the policy, applies mutate to a copy of it and updates
+// only the sections that were changed by mutate.
+// Setting a section to nil does not remove it from the policy.
+// If nothing was changed, no update request is sent.
+func (c *Client) PatchPolicy(policyID uint32, mutate func(*Policy) error, opts PatchPolicyOpts) (*UpdatePolicyResult, error) {
+	current, err := c.GetPolicy(policyID)
+	if err != nil {
+		return nil, err
+	}
+
+	modified, err := current.Copy()
+	if err != nil {
+		return nil, err
+	}
+	if err := mutate(modified); err != nil {
+		return nil, err
+	}
+
+	params, changed, err := changedPolicySections(current, modified)
+	if err != nil {
+		return nil, err
+	}
+	if !changed {
+		c.logf("[jamf-pro-go] Policy (ID: %d) is unchanged, skipping update", policyID)
+		return &UpdatePolicyResult{ID: policyID}, nil
+	}
+
+	if opts.CheckConcurrentModification {
+		latest, err := c.GetPolicy(policyID)
+		if err != nil {
+			return nil, err
+		}
+		before, err := xml.Marshal(current)
+		if err != nil {
+			return nil, err
+		}
+		after, err := xml.Marshal(latest)
+		if err != nil {
+			return nil, err
+		}
+		if !bytes.Equal(before, after) {
+			return nil, ErrPolicyModified
+		}
+	}
+
+	return c.UpdatePolicy(policyID, params)
+}
+
+// changedPolicySections returns update params holding the sections of after
+// that differ from before.
+func changedPolicySections(before, after *Policy) (*UpdatePolicyParams, bool, error) {
+	var params UpdatePolicyParams
+	changed := false
+
+	sections := []struct {
+		before, after interface{}
+		set           func()
+	}{
+		{before.General, after.General, func() { params.General = after.General }},
+		{before.Scope, after.Scope, func() { params.Scope = after.Scope }},
+		{before.SelfService, after.SelfService, func() { params.SelfService = after.SelfService }},
+		{before.PackageConfiguration, after.PackageConfiguration, func() { params.PackageConfiguration = after.PackageConfiguration }},
+		{before.Scripts, after.Scripts, func() { params.Scripts = after.Scripts }},
+		{before.Printers, after.Printers, func() { params.Printers = after.Printers }},
+		{before.DockItems, after.DockItems, func() { params.DockItems = after.DockItems }},
+		{before.AccountMaintenance, after.AccountMaintenance, func() { params.AccountMaintenance = after.AccountMaintenance }},
+		{before.RebootSettings, after.RebootSettings, func() { params.RebootSettings = after.RebootSettings }},
+		{before.Maintenance, after.Maintenance, func() { params.Maintenance = after.Maintenance }},
+		{before.FilesProcesses, after.FilesProcesses, func() { params.FilesProcesses = after.FilesProcesses }},
+		{before.UserInteraction, after.UserInteraction, func() { params.UserInteraction = after.UserInteraction }},
+		{before.DiskEncryption, after.DiskEncryption, func() { params.DiskEncryption = after.DiskEncryption }},
+	}
+
+	for _, s := range sections {
+		b, err := xml.Marshal(s.before)
+		if err != nil {
+			return nil, false, err
+		}
+		a, err := xml.Marshal(s.after)
+		if err != nil {
+			return nil, false, err
+		}
+		// a nil section in after is left as is
+		if len(a) > 0 && !bytes.Equal(a, b) {
+			s.set()
+			changed = true
+		}
+	}
+
+	return &params, changed, nil
+}
@@ -0,0 +1,144 @@
+package jamf_pro_go
+
+import (
+	"encoding/xml"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const patchedPolicyXML = `<policy>
+	<general><id>5</id><name>Install</name><frequency>Ongoing</frequency></general>
+	<scope><all_computers>true</all_computers></scope>
+	<scripts><size>1</size><script><id>41</id><priority>Before</priority></script></scripts>
+</policy>`
+
+// patchServer serves the policies of gets in turn, the last one repeatedly,
+// and records the updates.
+func patchServer(t *testing.T, gets ...string) (*Client, *[]*Policy, func()) {
+	t.Helper()
+	var updates []*Policy
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/JSSResource/policies/id/5" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		switch r.Method {
+		case http.MethodGet:
+			w.Write([]byte(gets[0]))
+			if len(gets) > 1 {
+				gets = gets[1:]
+			}
+		case http.MethodPut:
+			body, _ := ioutil.ReadAll(r.Body)
+			var p Policy
+			if err := xml.Unmarshal(body, &p); err != nil {
+				t.Errorf("decode %s: %v", body, err)
+			}
+			updates = append(updates, &p)
+			w.Write([]byte("<policy><id>5</id></policy>"))
+		}
+	}))
+	return NewClient(&Config{BaseURL: srv.URL}), &updates, srv.Close
+}
+
+func TestPatchPolicySections(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		mutate   func(*Policy) error
+		sections []string
+	}{
+		{"unchanged", func(p *Policy) error { return nil }, nil},
+		{"same value", func(p *Policy) error { p.General.Name = "Install"; return nil }, nil},
+		{"section set to nil", func(p *Policy) error { p.Scope = nil; return nil }, nil},
+		{"general", func(p *Policy) error { p.General.Name = "Install v2"; return nil }, []string{"general"}},
+		{"scripts", func(p *Policy) error {
+			p.Scripts.PolicyScript[0].Parameter4 = "1.2"
+			return nil
+		}, []string{"scripts"}},
+		{"new sections", func(p *Policy) error {
+			p.Scope.AllComputers = Bool(false)
+			p.Printers = &PolicyPrinters{Printer: []*PolicyPrinter{{ID: 3, Action: "install"}}}
+			return nil
+		}, []string{"scope", "printers"}},
+	} {
+		c, updates, done := patchServer(t, patchedPolicyXML)
+		result, err := c.PatchPolicy(5, tt.mutate, PatchPolicyOpts{})
+		done()
+		if err != nil || result.ID != 5 {
+			t.Errorf("%s: PatchPolicy() = %+v, %v", tt.name, result, err)
+			continue
+		}
+
+		if tt.sections == nil {
+			if len(*updates) != 0 {
+				t.Errorf("%s: %d updates sent, want none", tt.name, len(*updates))
+			}
+			continue
+		}
+		if len(*updates) != 1 {
+			t.Errorf("%s: %d updates sent, want 1", tt.name, len(*updates))
+			continue
+		}
+		if got := policySections((*updates)[0]); !reflect.DeepEqual(got, tt.sections) {
+			t.Errorf("%s: sections sent = %v, want %v", tt.name, got, tt.sections)
+		}
+	}
+}
+
+func TestPatchPolicyMutateError(t *testing.T) {
+	c, updates, done := patchServer(t, patchedPolicyXML)
+	defer done()
+
+	mutateErr := errors.New("no")
+	_, err := c.PatchPolicy(5, func(p *Policy) error {
+		p.General.Name = "changed"
+		return mutateErr
+	}, PatchPolicyOpts{})
+	if err != mutateErr || len(*updates) != 0 {
+		t.Errorf("error = %v with %d updates, want the mutate error and none", err, len(*updates))
+	}
+}
+
+func TestPatchPolicyConcurrentModification(t *testing.T) {
+	rename := func(p *Policy) error { p.General.Name = "Install v2"; return nil }
+	modified := `<policy><general><id>5</id><name>Install (edited)</name><frequency>Ongoing</frequency></general></policy>`
+
+	c, updates, done := patchServer(t, patchedPolicyXML, modified)
+	_, err := c.PatchPolicy(5, rename, PatchPolicyOpts{CheckConcurrentModification: true})
+	done()
+	if !errors.Is(err, ErrPolicyModified) || len(*updates) != 0 {
+		t.Errorf("error = %v with %d updates, want ErrPolicyModified and none", err, len(*updates))
+	}
+
+	// without the check, the update overwrites the other change
+	c, updates, done = patchServer(t, patchedPolicyXML, modified)
+	_, err = c.PatchPolicy(5, rename, PatchPolicyOpts{})
+	done()
+	if err != nil || len(*updates) != 1 {
+		t.Errorf("error = %v with %d updates, want one update", err, len(*updates))
+	}
+
+	// an unmodified policy passes the check
+	c, updates, done = patchServer(t, patchedPolicyXML)
+	_, err = c.PatchPolicy(5, rename, PatchPolicyOpts{CheckConcurrentModification: true})
+	done()
+	if err != nil || len(*updates) != 1 || (*updates)[0].General.Name != "Install v2" {
+		t.Errorf("error = %v with updates %+v, want one update", err, *updates)
+	}
+}
+
+// policySections returns the XML names of the sections set in p, in order.
+func policySections(p *Policy) []string {
+	var names []string
+	v := reflect.ValueOf(p).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if f := v.Field(i); f.Kind() == reflect.Ptr && !f.IsNil() {
+			names = append(names, strings.Split(v.Type().Field(i).Tag.Get("xml"), ",")[0])
+		}
+	}
+	return names
+}
@@ -0,0 +1,216 @@
+package jamf_pro_go
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PolicyTrigger tells how a policy is started: on events, including custom
+// ones, or by the user in Self Service.
+type PolicyTrigger string
+
+const (
+	PolicyTriggerEvent         PolicyTrigger = "EVENT"
+	PolicyTriggerUserInitiated PolicyTrigger = "USER_INITIATED"
+)
+
+var policyNoExecuteDays = []string{"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat"}
+
+// Validate checks a complete policy, as sent to CreatePolicy, and returns a
+// *ValidationError listing every problem found.
+func (p *Policy) Validate() error {
+	return p.validate(false)
+}
+
+// ValidateUpdate checks a partial policy, as sent to UpdatePolicy.
+// Sections and fields left unset are not required.
+func (p *Policy) ValidateUpdate() error {
+	return p.validate(true)
+}
+
+func (p *Policy) validate(partial bool) error {
+	v := &ValidationError{}
+
+	if p.General == nil {
+		if !partial {
+			v.add("general.name", "policy name is required")
+		}
+	} else {
+		p.General.validate(v, partial)
+		selfService := p.SelfService != nil && BoolValue(p.SelfService.UseForSelfService)
+		if !partial && !selfService && strings.EqualFold(string(p.General.Trigger), string(PolicyTriggerEvent)) && !p.General.hasTrigger() {
+			v.add("general.trigger_other", "an %s policy needs a custom trigger or at least one event trigger", PolicyTriggerEvent)
+		}
+	}
+
+	if p.PackageConfiguration != nil && p.PackageConfiguration.Packages != nil {
+		for i, pkg := range p.PackageConfiguration.Packages.Package {
+			field := fmt.Sprintf("package_configuration.packages[%d]", i)
+			if pkg.ID == 0 {
+				v.add(field+".id", "package ID is required")
+			}
+			checkEnum(v, field+".action", pkg.Action.Validate())
+		}
+	}
+
+	if p.Scripts != nil {
+		for i, s := range p.Scripts.PolicyScript {
+			field := fmt.Sprintf("scripts[%d]", i)
+			if s.ID == 0 {
+				v.add(field+".id", "script ID is required")
+			}
+			checkEnum(v, field+".priority", s.Priority.Validate())
+		}
+	}
+
+	if p.Printers != nil {
+		for i, printer := range p.Printers.Printer {
+			field := fmt.Sprintf("printers[%d]", i)
+			if printer.ID == 0 {
+				v.add(field+".id", "printer ID is required")
+			}
+			checkEnum(v, field+".action", printer.Action.Validate())
+		}
+	}
+
+	if p.DockItems != nil {
+		for i, item := range p.DockItems.DockItem {
+			field := fmt.Sprintf("dock_items[%d]", i)
+			if item.ID == 0 {
+				v.add(field+".id", "dock item ID is required")
+			}
+			checkEnum(v, field+".action", item.Action.Validate())
+		}
+	}
+
+	if p.AccountMaintenance != nil {
+		p.AccountMaintenance.validate(v)
+	}
+
+	if r := p.RebootSettings; r != nil {
+		if r.Message == "" && (restarts(r.NoUserLoggedIn) || restarts(r.UserLoggedIn)) {
+			v.add("reboot.message", "message is required when the computer restarts")
+		}
+		if r.StartupDisk == "Specify Local Startup Disk" && r.SpecifyStartup == "" {
+			v.add("reboot.specify_startup", "startup disk is required for %q", r.StartupDisk)
+		}
+	}
+
+	if d := p.DiskEncryption; d != nil {
+		checkEnum(v, "disk_encryption.action", d.Action.Validate())
+		action, _ := normalizeEnum("", string(d.Action), diskEncryptionActions)
+		switch DiskEncryptionAction(action) {
+		case DiskEncryptionActionApply:
+			if d.DiskEncryptionConfigurationID == 0 {
+				v.add("disk_encryption.disk_encryption_configuration_id", "configuration ID is required for %q", d.Action)
+			}
+		case DiskEncryptionActionRemediate:
+			if d.RemediateKeyType == "" {
+				v.add("disk_encryption.remediate_key_type", "key type is required for %q", d.Action)
+			}
+			if d.RemediateDiskEncryptionConfigurationID == 0 {
+				v.add("disk_encryption.remediate_disk_encryption_configuration_id", "configuration ID is required for %q", d.Action)
+			}
+		}
+	}
+
+	return v.err()
+}
+
+func (g *PolicyGeneral) validate(v *ValidationError, partial bool) {
+	if g.Name == "" && !partial {
+		v.add("general.name", "policy name is required")
+	}
+
+	checkEnum(v, "general.frequency", g.Frequency.Validate())
+
+	if strings.TrimSpace(g.TriggerOther) != g.TriggerOther {
+		v.add("general.trigger_other", "custom trigger %q has leading or trailing spaces", g.TriggerOther)
+	}
+
+	if g.RetryAttempts < -1 || g.RetryAttempts > 10 {
+		v.add("general.retry_attempts", "must be between -1 and 10, got %d", g.RetryAttempts)
+	}
+	if g.RetryEvent != "" && g.RetryEvent != "none" {
+		if g.RetryAttempts <= 0 {
+			v.add("general.retry_attempts", "retry attempts are required for retry event %q", g.RetryEvent)
+		}
+		frequency, _ := normalizeEnum("", string(g.Frequency), policyFrequencies)
+		if frequency != "" && PolicyFrequency(frequency) != PolicyFrequencyOncePerComputer && PolicyFrequency(frequency) != PolicyFrequencyOncePerUserPerComputer {
+			v.add("general.retry_event", "retrying is only available for once per computer policies")
+		}
+	}
+
+	if d := g.DateTimeLimitations; d != nil {
+		if d.NoExecuteOn != nil {
+			for i, day := range d.NoExecuteOn.Day {
+				if _, err := normalizeEnum("day", day, policyNoExecuteDays); err != nil {
+					v.add(fmt.Sprintf("general.date_time_limitations.no_execute_on[%d]", i),
+						"invalid day %q (allowed: %s)", day, strings.Join(policyNoExecuteDays, ", "))
+				}
+			}
+		}
+		if (d.NoExecuteStart == "") != (d.NoExecuteEnd == "") {
+			v.add("general.date_time_limitations", "no_execute_start and no_execute_end must be set together")
+		}
+	}
+}
+
+func (a *PolicyAccountMaintenance) validate(v *ValidationError) {
+	if a.Accounts != nil {
+		for i, account := range a.Accounts.Account {
+			field := fmt.Sprintf("account_maintenance.accounts[%d]", i)
+			checkEnum(v, field+".action", account.Action.Validate())
+			if account.Action != "" && account.UserName == "" {
+				v.add(field+".username", "username is required for %q", account.Action)
+			}
+			if strings.EqualFold(string(account.Action), string(AccountActionCreate)) && account.Password == "" {
+				v.add(field+".password", "password is required for %q", account.Action)
+			}
+		}
+	}
+
+	if a.DirectoryBindings != nil {
+		for i, binding := range a.DirectoryBindings.Binding {
+			if binding.ID == 0 {
+				v.add(fmt.Sprintf("account_maintenance.directory_bindings[%d].id", i), "directory binding ID is required")
+			}
+		}
+	}
+
+	if m := a.ManagementAccount; m != nil {
+		checkEnum(v, "account_maintenance.management_account.action", m.Action.Validate())
+		action, _ := normalizeEnum("", string(m.Action), managementAccountActions)
+		switch ManagementAccountAction(action) {
+		case ManagementAccountActionSpecified:
+			if m.ManagedPassword == "" {
+				v.add("account_maintenance.management_account.managed_password", "password is required for %q", m.Action)
+			}
+		case ManagementAccountActionRandom:
+			if m.ManagedPasswordLength == 0 {
+				v.add("account_maintenance.management_account.managed_password_length", "password length is required for %q", m.Action)
+			}
+		}
+	}
+}
+
+func (g *PolicyGeneral) hasTrigger() bool {
+	return g.TriggerOther != "" ||
+		BoolValue(g.TriggerCheckin) ||
+		BoolValue(g.TriggerEnrollmentComplete) ||
+		BoolValue(g.TriggerLogin) ||
+		BoolValue(g.TriggerLogout) ||
+		BoolValue(g.TriggerNetworkStateChanged) ||
+		BoolValue(g.TriggerStartup)
+}
+
+// restarts reports whether a reboot setting restarts the computer.
+func restarts(setting string) bool {
+	return setting != "" && setting != "Do not restart"
+}
+
+func checkEnum(v *ValidationError, field string, err error) {
+	if e, ok := err.(*InvalidValueError); ok {
+		v.add(field, "invalid value %q (allowed: %s)", e.Value, strings.Join(e.Allowed, ", "))
+	}
+}
@@ -0,0 +1,144 @@
+package jamf_pro_go
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	valid := func() *Policy {
+		return &Policy{General: &PolicyGeneral{Name: "p", Trigger: PolicyTriggerEvent, TriggerCheckin: Bool(true)}}
+	}
+
+	for _, tt := range []struct {
+		name    string
+		change  func(p *Policy)
+		partial bool
+		want    []string // fields with problems
+	}{
+		{"valid", func(p *Policy) {}, false, nil},
+		{"no general", func(p *Policy) { p.General = nil }, false, []string{"general.name"}},
+		{"no name", func(p *Policy) { p.General.Name = "" }, false, []string{"general.name"}},
+
+		// partial mode
+		{"partial without general", func(p *Policy) { p.General = nil }, true, nil},
+		{"partial without name", func(p *Policy) { p.General.Name = "" }, true, nil},
+		{"partial checks set fields", func(p *Policy) { p.General = &PolicyGeneral{Frequency: "hourly"} }, true, []string{"general.frequency"}},
+
+		// event trigger
+		{"custom trigger", func(p *Policy) { p.General.TriggerCheckin = nil; p.General.TriggerOther = "install" }, false, nil},
+		{"no trigger", func(p *Policy) { p.General.TriggerCheckin = nil }, false, []string{"general.trigger_other"}},
+		{"no trigger, partial", func(p *Policy) { p.General.TriggerCheckin = nil }, true, nil},
+		{"custom trigger with spaces", func(p *Policy) { p.General.TriggerOther = " install" }, false, []string{"general.trigger_other"}},
+
+		// self service
+		{"self service without trigger", func(p *Policy) {
+			p.General.TriggerCheckin = nil
+			p.SelfService = &PolicySelfService{UseForSelfService: Bool(true)}
+		}, false, nil},
+		{"self service disabled without trigger", func(p *Policy) {
+			p.General.TriggerCheckin = nil
+			p.SelfService = &PolicySelfService{UseForSelfService: Bool(false)}
+		}, false, []string{"general.trigger_other"}},
+
+		// retries
+		{"retry", func(p *Policy) {
+			p.General.Frequency = PolicyFrequencyOncePerComputer
+			p.General.RetryEvent = "trigger"
+			p.General.RetryAttempts = 3
+		}, false, nil},
+		{"too many retry attempts", func(p *Policy) { p.General.RetryAttempts = 11 }, false, []string{"general.retry_attempts"}},
+		{"retry event without attempts", func(p *Policy) { p.General.RetryEvent = "check-in" }, false, []string{"general.retry_attempts"}},
+		{"retry of an ongoing policy", func(p *Policy) {
+			p.General.Frequency = PolicyFrequencyOngoing
+			p.General.RetryEvent = "trigger"
+			p.General.RetryAttempts = 3
+		}, false, []string{"general.retry_event"}},
+
+		// reboot
+		{"reboot with message", func(p *Policy) {
+			p.RebootSettings = &PolicyRebootSettings{UserLoggedIn: "Restart", Message: "bye"}
+		}, false, nil},
+		{"no restart without message", func(p *Policy) {
+			p.RebootSettings = &PolicyRebootSettings{UserLoggedIn: "Do not restart", NoUserLoggedIn: "Do not restart"}
+		}, false, nil},
+		{"reboot without message", func(p *Policy) {
+			p.RebootSettings = &PolicyRebootSettings{NoUserLoggedIn: "Restart immediately"}
+		}, false, []string{"reboot.message"}},
+		{"startup disk", func(p *Policy) {
+			p.RebootSettings = &PolicyRebootSettings{StartupDisk: "Specify Local Startup Disk"}
+		}, false, []string{"reboot.specify_startup"}},
+
+		// lists and enums
+		{"script", func(p *Policy) {
+			p.Scripts = &PolicyScripts{PolicyScript: []*PolicyScript{{ID: 1, Priority: "after"}}}
+		}, false, nil},
+		{"script without ID", func(p *Policy) {
+			p.Scripts = &PolicyScripts{PolicyScript: []*PolicyScript{{Priority: "during"}}}
+		}, false, []string{"scripts[0].id", "scripts[0].priority"}},
+		{"no execute days", func(p *Policy) {
+			p.General.DateTimeLimitations = &PolicyDateTimeLimitations{
+				NoExecuteOn:    &PolicyNoExecuteOn{Day: []string{"sun", "Funday"}},
+				NoExecuteStart: "1:00 AM",
+			}
+		}, false, []string{"general.date_time_limitations.no_execute_on[1]", "general.date_time_limitations"}},
+		{"disk encryption", func(p *Policy) {
+			p.DiskEncryption = &PolicyDiskEncryption{Action: DiskEncryptionActionApply}
+		}, false, []string{"disk_encryption.disk_encryption_configuration_id"}},
+	} {
+		p := valid()
+		tt.change(p)
+		var err error
+		if tt.partial {
+			err = p.ValidateUpdate()
+		} else {
+			err = p.Validate()
+		}
+
+		var got []string
+		var v *ValidationError
+		if errors.As(err, &v) {
+			for _, problem := range v.Problems {
+				got = append(got, problem.Field)
+			}
+		} else if err != nil {
+			t.Errorf("%s: error = %v, want a *ValidationError", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: problems in %v, want %v (%v)", tt.name, got, tt.want, err)
+		}
+	}
+}
+
+func TestCreatePolicyValidates(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Write([]byte("<policy><id>1</id></policy>"))
+	}))
+	defer srv.Close()
+
+	// an event policy without any trigger is only rejected when asked
+	policy := &Policy{General: &PolicyGeneral{Name: "p", Trigger: PolicyTriggerEvent}}
+
+	c := NewClient(&Config{BaseURL: srv.URL, ValidatePolicies: true})
+	var v *ValidationError
+	if _, err := c.CreatePolicy(policy); !errors.As(err, &v) {
+		t.Errorf("CreatePolicy() error = %v, want a *ValidationError", err)
+	}
+	if requests != 0 {
+		t.Errorf("%d requests sent, want none", requests)
+	}
+
+	c = NewClient(&Config{BaseURL: srv.URL})
+	if _, err := c.CreatePolicy(policy); err != nil {
+		t.Errorf("CreatePolicy() without ValidatePolicies: %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("%d requests sent, want 1", requests)
+	}
+}
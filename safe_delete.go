@@ -0,0 +1,125 @@
+package jamf_pro_go
+
+import "fmt"
+
+type SafeDeleteOpts struct {
+	// Force deletes the object without checking what uses it.
+	Force bool
+	// Graph is checked instead of a graph read from the server, which saves
+	// reading every policy when deleting several objects.
+	Graph *DependencyGraph
+}
+
+// SafeDeleteScript deletes the script unless a policy runs it, in which case
+// it returns a *DependencyError listing these policies.
+func (c *Client) SafeDeleteScript(scriptID uint32, opts SafeDeleteOpts) error {
+	if !opts.Force {
+		if err := c.checkDependents(DependencyScript, fmt.Sprint(scriptID), opts); err != nil {
+			return err
+		}
+	}
+	return c.DeleteScript(scriptID)
+}
+
+// SafeDeletePolicy deletes the policy unless it is enabled and scoped, in
+// which case it returns a *DependencyError listing the computers and computer
+// groups in its Scope. No object uses a policy, so Dependents is empty: the
+// check is that deleting the policy does not silently stop it running.
+func (c *Client) SafeDeletePolicy(policyID uint32, opts SafeDeleteOpts) error {
+	if !opts.Force {
+		policy, err := c.GetPolicy(policyID)
+		if err != nil {
+			return err
+		}
+		if err := policyInUse(policy); err != nil {
+			return err
+		}
+	}
+	return c.DeletePolicy(policyID)
+}
+
+// SafeDeleteCategory deletes the category unless a policy or a script is in
+// it, in which case it returns a *DependencyError listing them.
+func (c *Client) SafeDeleteCategory(categoryID uint32, opts SafeDeleteOpts) error {
+	if !opts.Force {
+		if err := c.checkDependents(DependencyCategory, fmt.Sprint(categoryID), opts); err != nil {
+			return err
+		}
+	}
+	return c.DeleteCategory(categoryID)
+}
+
+func (c *Client) checkDependents(kind DependencyKind, id string, opts SafeDeleteOpts) error {
+	g := opts.Graph
+	if g == nil {
+		var err error
+		if g, err = c.GetDependencyGraph(); err != nil {
+			return err
+		}
+	}
+	return g.checkDependents(kind, id)
+}
+
+// checkDependents returns a *DependencyError if anything uses the object.
+func (g *DependencyGraph) checkDependents(kind DependencyKind, id string) error {
+	edges := g.Dependents(kind, id)
+	if len(edges) == 0 {
+		return nil
+	}
+	object, ok := g.Node(kind, id)
+	if !ok {
+		object = DependencyNode{DependencyRef: DependencyRef{Kind: kind, ID: id}}
+	}
+	e := &DependencyError{Object: object}
+	seen := map[DependencyRef]bool{}
+	for _, edge := range edges {
+		if seen[edge.From] {
+			continue
+		}
+		seen[edge.From] = true
+		n, _ := g.Node(edge.From.Kind, edge.From.ID)
+		e.Dependents = append(e.Dependents, n)
+	}
+	return e
+}
+
+// policyInUse returns a *DependencyError holding the scope of the policy if
+// it is enabled and scoped to computers.
+func policyInUse(p *Policy) error {
+	if p.General == nil || !BoolValue(p.General.Enabled) || p.Scope == nil {
+		return nil
+	}
+	e := &DependencyError{
+		Object: DependencyNode{
+			DependencyRef: DependencyRef{Kind: DependencyPolicy, ID: fmt.Sprint(p.General.ID)},
+			Name:          p.General.Name,
+		},
+	}
+	s := p.Scope
+	if BoolValue(s.AllComputers) {
+		e.Scope = append(e.Scope, DependencyNode{
+			DependencyRef: DependencyRef{Kind: DependencyComputerGroup, ID: "all"},
+			Name:          "All Computers",
+		})
+	}
+	if s.Computers != nil {
+		for _, c := range s.Computers.Computer {
+			e.Scope = append(e.Scope, DependencyNode{
+				DependencyRef: DependencyRef{Kind: DependencyComputer, ID: fmt.Sprint(c.ID)},
+				Name:          c.Name,
+			})
+		}
+	}
+	if s.ComputerGroups != nil {
+		for _, g := range s.ComputerGroups.ComputerGroup {
+			e.Scope = append(e.Scope, DependencyNode{
+				DependencyRef: DependencyRef{Kind: DependencyComputerGroup, ID: fmt.Sprint(g.ID)},
+				Name:          g.Name,
+			})
+		}
+	}
+	if len(e.Scope) == 0 {
+		return nil
+	}
+	return e
+}
@@ -0,0 +1,113 @@
+package jamf_pro_go
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestSafeDeleteWithGraph(t *testing.T) {
+	var deleted []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		deleted = append(deleted, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+	c := NewClient(&Config{BaseURL: srv.URL})
+
+	graph := NewDependencyGraph([]*Policy{{
+		General: &PolicyGeneral{ID: 5, Name: "Install"},
+		Scripts: &PolicyScripts{PolicyScript: []*PolicyScript{{ID: 41}}},
+	}}, []Script{
+		{ID: "41", Name: "install.sh", CategoryID: "3", CategoryName: "Tools"},
+		{ID: "42", Name: "unused.sh"},
+	})
+
+	err := c.SafeDeleteScript(41, SafeDeleteOpts{Graph: graph})
+	var dep *DependencyError
+	if !errors.As(err, &dep) {
+		t.Fatalf("SafeDeleteScript(41) error = %v, want a *DependencyError", err)
+	}
+	wantObject := DependencyNode{DependencyRef: DependencyRef{Kind: DependencyScript, ID: "41"}, Name: "install.sh"}
+	wantDependents := []DependencyNode{{DependencyRef: DependencyRef{Kind: DependencyPolicy, ID: "5"}, Name: "Install"}}
+	if dep.Object != wantObject || !reflect.DeepEqual(dep.Dependents, wantDependents) || dep.Scope != nil {
+		t.Errorf("error = %+v", dep)
+	}
+	if want := `[jamf-pro-go] script 41 ("install.sh") is used by policy 5 ("Install")`; err.Error() != want {
+		t.Errorf("message = %s, want %s", err, want)
+	}
+
+	err = c.SafeDeleteCategory(3, SafeDeleteOpts{Graph: graph})
+	if !errors.As(err, &dep) || len(dep.Dependents) != 1 || dep.Dependents[0].Name != "install.sh" {
+		t.Errorf("SafeDeleteCategory(3) error = %v, want one dependent script", err)
+	}
+
+	if err := c.SafeDeleteScript(42, SafeDeleteOpts{Graph: graph}); err != nil {
+		t.Errorf("SafeDeleteScript(42): %v", err)
+	}
+	if err := c.SafeDeleteScript(41, SafeDeleteOpts{Force: true}); err != nil {
+		t.Errorf("SafeDeleteScript(41) with force: %v", err)
+	}
+
+	want := []string{"/uapi/v1/scripts/42", "/uapi/v1/scripts/41"}
+	if !reflect.DeepEqual(deleted, want) {
+		t.Errorf("deleted %v, want %v", deleted, want)
+	}
+}
+
+func TestSafeDeletePolicy(t *testing.T) {
+	policies := map[string]string{
+		"/JSSResource/policies/id/5": `<policy><general><id>5</id><name>Install</name><enabled>true</enabled></general>` +
+			`<scope><all_computers>false</all_computers><computer_groups><computer_group><id>7</id><name>Lab</name></computer_group></computer_groups></scope></policy>`,
+		"/JSSResource/policies/id/6": `<policy><general><id>6</id><name>Old</name><enabled>false</enabled></general>` +
+			`<scope><all_computers>true</all_computers></scope></policy>`,
+	}
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		if r.Method == http.MethodDelete {
+			w.Write([]byte("<policy><id>0</id></policy>"))
+			return
+		}
+		w.Write([]byte(policies[r.URL.Path]))
+	}))
+	defer srv.Close()
+	c := NewClient(&Config{BaseURL: srv.URL})
+
+	err := c.SafeDeletePolicy(5, SafeDeleteOpts{})
+	var dep *DependencyError
+	if !errors.As(err, &dep) {
+		t.Fatalf("SafeDeletePolicy(5) error = %v, want a *DependencyError", err)
+	}
+	wantScope := []DependencyNode{{DependencyRef: DependencyRef{Kind: DependencyComputerGroup, ID: "7"}, Name: "Lab"}}
+	if dep.Dependents != nil || !reflect.DeepEqual(dep.Scope, wantScope) {
+		t.Errorf("error = %+v, want the group in the scope only", dep)
+	}
+	if want := `[jamf-pro-go] policy 5 ("Install") is enabled and scoped to computer_group 7 ("Lab")`; err.Error() != want {
+		t.Errorf("message = %s, want %s", err, want)
+	}
+
+	// disabled
+	if err := c.SafeDeletePolicy(6, SafeDeleteOpts{}); err != nil {
+		t.Errorf("SafeDeletePolicy(6): %v", err)
+	}
+	// forced, without reading the policy
+	if err := c.SafeDeletePolicy(5, SafeDeleteOpts{Force: true}); err != nil {
+		t.Errorf("SafeDeletePolicy(5) with force: %v", err)
+	}
+
+	want := []string{
+		"GET /JSSResource/policies/id/5",
+		"GET /JSSResource/policies/id/6",
+		"DELETE /JSSResource/policies/id/6",
+		"DELETE /JSSResource/policies/id/5",
+	}
+	if !reflect.DeepEqual(requests, want) {
+		t.Errorf("requests = %v, want %v", requests, want)
+	}
+}
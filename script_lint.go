@@ -0,0 +1,218 @@
+package jamf_pro_go
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// LintSeverity tells whether a LintFinding blocks an upload (error) or not.
+type LintSeverity string
+
+const (
+	LintError   LintSeverity = "error"
+	LintWarning LintSeverity = "warning"
+)
+
+// LintFinding is a problem found by LintScript.
+// Line is 1-based, or 0 when the finding is not about a line.
+type LintFinding struct {
+	Rule     string       `json:"rule"`
+	Severity LintSeverity `json:"severity"`
+	Line     int          `json:"line,omitempty"`
+	Message  string       `json:"message"`
+}
+
+func (f LintFinding) String() string {
+	if f.Line > 0 {
+		return fmt.Sprintf("%s: line %d: %s (%s)", f.Severity, f.Line, f.Message, f.Rule)
+	}
+	return fmt.Sprintf("%s: %s (%s)", f.Severity, f.Message, f.Rule)
+}
+
+// interpreters found on a stock macOS
+var knownInterpreters = map[string]bool{
+	"/bin/sh":            true,
+	"/bin/bash":          true,
+	"/bin/zsh":           true,
+	"/bin/ksh":           true,
+	"/bin/csh":           true,
+	"/bin/tcsh":          true,
+	"/bin/dash":          true,
+	"/usr/bin/perl":      true,
+	"/usr/bin/ruby":      true,
+	"/usr/bin/python3":   true,
+	"/usr/bin/swift":     true,
+	"/usr/bin/osascript": true,
+	"/usr/bin/env":       true,
+	"/usr/bin/awk":       true,
+}
+
+var (
+	shellParameterPattern  = regexp.MustCompile(`\$\{([0-9]+)\}|\$([0-9])([0-9]?)`)
+	pythonParameterPattern = regexp.MustCompile(`sys\.argv\[([0-9]+)\]`)
+	osRequirementPattern   = regexp.MustCompile(`^[0-9]+(\.[0-9]+)*(\.x)?$`)
+)
+
+// LintScript checks a script before it is uploaded: the shebang and its
+// interpreter, line endings, the use of the parameters 4 to 11 against their
+// labels, and the format of the OS requirements.
+func LintScript(params ScriptParams) []LintFinding {
+	var findings []LintFinding
+	add := func(rule string, severity LintSeverity, line int, format string, a ...interface{}) {
+		findings = append(findings, LintFinding{Rule: rule, Severity: severity, Line: line, Message: fmt.Sprintf(format, a...)})
+	}
+
+	contents := params.ScriptContents
+	if strings.TrimSpace(contents) == "" {
+		add("empty", LintError, 0, "script has no contents")
+		return findings
+	}
+
+	lines := strings.Split(contents, "\n")
+
+	// shebang and interpreter
+	interpreter := ""
+	if !strings.HasPrefix(lines[0], "#!") {
+		add("shebang", LintError, 1, "script does not start with a shebang, e.g. #!/bin/bash")
+	} else {
+		fields := strings.Fields(strings.TrimSuffix(strings.TrimPrefix(lines[0], "#!"), "\r"))
+		if len(fields) == 0 {
+			add("shebang", LintError, 1, "shebang has no interpreter")
+		} else {
+			interpreter = fields[0]
+			if interpreter == "/usr/bin/env" && len(fields) > 1 {
+				interpreter = fields[1]
+			}
+			switch {
+			case interpreter == "/usr/bin/python" || interpreter == "python":
+				add("interpreter", LintError, 1, "%s (Python 2) was removed in macOS 12.3", interpreter)
+			case strings.HasPrefix(interpreter, "/") && !knownInterpreters[interpreter]:
+				add("interpreter", LintWarning, 1, "%s is not an interpreter of a stock macOS", interpreter)
+			}
+		}
+	}
+
+	// line endings
+	for i, line := range lines {
+		if strings.HasSuffix(line, "\r") {
+			add("line-endings", LintError, i+1, "script has Windows (CRLF) line endings")
+			break
+		}
+	}
+
+	// parameters
+	used := map[int]int{} // slot -> first line
+	python := strings.HasPrefix(path.Base(interpreter), "python")
+	for i, line := range lines {
+		if python {
+			for _, m := range pythonParameterPattern.FindAllStringSubmatch(line, -1) {
+				markParameter(used, m[1], i+1)
+			}
+			continue
+		}
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		for _, m := range shellParameterMatches(line) {
+			switch {
+			case m[1] != "":
+				markParameter(used, m[1], i+1)
+			case m[2] == "1" && m[3] != "":
+				// $10 is $1 followed by 0 in a shell, mark the parameter that was meant
+				add("parameters", LintWarning, i+1, "$1%s expands to parameter 1 followed by %q, use ${1%s}", m[3], m[3], m[3])
+				markParameter(used, m[2]+m[3], i+1)
+			default:
+				markParameter(used, m[2], i+1)
+			}
+		}
+	}
+	for n := 4; n <= 11; n++ {
+		label := *params.parameter(n)
+		line, ok := used[n]
+		switch {
+		case ok && label == "":
+			add("parameters", LintError, line, "parameter %d is used but has no label (Parameter%d)", n, n)
+		case !ok && label != "":
+			add("parameters", LintWarning, 0, "parameter %d is labelled %q but never used", n, label)
+		}
+	}
+
+	// OS requirements
+	if params.OsRequirements != "" {
+		for _, r := range strings.Split(params.OsRequirements, ",") {
+			r = strings.TrimSpace(r)
+			if !osRequirementPattern.MatchString(r) {
+				add("os-requirements", LintError, 0, "invalid OS requirement %q, expected versions such as 10.15.x, 11.x or 12.3", r)
+			}
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Line < findings[j].Line
+	})
+	return findings
+}
+
+// Lint checks the script with LintScript.
+func (s *Script) Lint() []LintFinding {
+	return LintScript(s.params())
+}
+
+// shellParameterMatches returns the matches of shellParameterPattern the
+// shell expands, leaving out escaped ones (\$4) and those in single quotes.
+// Quotes are followed within the line only.
+func shellParameterMatches(line string) [][]string {
+	var matches [][]string
+	quoted, doubleQuoted, escaped, pos := false, false, false, 0
+	for _, loc := range shellParameterPattern.FindAllStringSubmatchIndex(line, -1) {
+		for ; pos < loc[0]; pos++ {
+			switch {
+			case escaped:
+				escaped = false
+			case quoted:
+				quoted = line[pos] != '\''
+			case line[pos] == '\\':
+				escaped = true
+			case line[pos] == '"':
+				doubleQuoted = !doubleQuoted
+			case line[pos] == '\'' && !doubleQuoted:
+				quoted = true
+			}
+		}
+		if quoted || escaped {
+			continue
+		}
+		m := make([]string, len(loc)/2)
+		for i := range m {
+			if loc[2*i] >= 0 {
+				m[i] = line[loc[2*i]:loc[2*i+1]]
+			}
+		}
+		matches = append(matches, m)
+	}
+	return matches
+}
+
+func markParameter(used map[int]int, slot string, line int) {
+	n, err := strconv.Atoi(slot)
+	if err != nil {
+		return
+	}
+	if _, ok := used[n]; !ok {
+		used[n] = line
+	}
+}
+
+// lintErrors returns a *ScriptLintError if any finding is an error.
+func lintErrors(name string, findings []LintFinding) error {
+	for _, f := range findings {
+		if f.Severity == LintError {
+			return &ScriptLintError{Name: name, Findings: findings}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,109 @@
+package jamf_pro_go
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLintScriptParameters(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		contents string
+		labels   map[int]string
+		want     []string
+	}{
+		{
+			"used and labelled",
+			"#!/bin/bash\necho \"$4\" ${5}",
+			map[int]string{4: "Site", 5: "Mode"},
+			nil,
+		},
+		{
+			"used without label",
+			"#!/bin/bash\n\necho $6",
+			nil,
+			[]string{"error: line 3: parameter 6 is used but has no label (Parameter6) (parameters)"},
+		},
+		{
+			"labelled but not used",
+			"#!/bin/bash\necho done",
+			map[int]string{7: "Unused"},
+			[]string{`warning: parameter 7 is labelled "Unused" but never used (parameters)`},
+		},
+		{
+			"$10",
+			"#!/bin/bash\necho $10",
+			map[int]string{10: "Tenth"},
+			[]string{`warning: line 2: $10 expands to parameter 1 followed by "0", use ${10} (parameters)`},
+		},
+		{
+			"${10} and ${11}",
+			"#!/bin/zsh\necho ${10} ${11}",
+			map[int]string{10: "Tenth", 11: "Eleventh"},
+			nil,
+		},
+		{
+			"comments",
+			"#!/bin/bash\n# usage: script $4\n  # $5 is unused\necho ok",
+			nil,
+			nil,
+		},
+		{
+			"escaped and single quoted",
+			"#!/bin/bash\necho \\$4 '$5 ${6}' \"it's $7\"\necho '\\' $8",
+			map[int]string{7: "Seventh", 8: "Eighth"},
+			nil,
+		},
+		{
+			"escaped backslash",
+			"#!/bin/bash\necho \\\\$4",
+			nil,
+			[]string{"error: line 2: parameter 4 is used but has no label (Parameter4) (parameters)"},
+		},
+		{
+			"python sys.argv",
+			"#!/usr/bin/env python3\nimport sys\nsite = sys.argv[4]\n# $5 is not a parameter\n",
+			map[int]string{4: "Site", 9: "Ninth"},
+			[]string{`warning: parameter 9 is labelled "Ninth" but never used (parameters)`},
+		},
+	} {
+		params := ScriptParams{Name: "test.sh", ScriptContents: tt.contents}
+		for n, label := range tt.labels {
+			*params.parameter(n) = label
+		}
+		var got []string
+		for _, f := range LintScript(params) {
+			if f.Rule == "parameters" {
+				got = append(got, f.String())
+			}
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: LintScript() =\n%s\nwant\n%s", tt.name, strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+		}
+	}
+}
+
+func TestLintScript(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		params ScriptParams
+		want   []string
+	}{
+		{"empty", ScriptParams{ScriptContents: " \n"}, []string{"empty"}},
+		{"no shebang", ScriptParams{ScriptContents: "echo hi"}, []string{"shebang"}},
+		{"python 2", ScriptParams{ScriptContents: "#!/usr/bin/python\nprint 1"}, []string{"interpreter"}},
+		{"unknown interpreter", ScriptParams{ScriptContents: "#!/opt/bin/fish\necho"}, []string{"interpreter"}},
+		{"crlf", ScriptParams{ScriptContents: "#!/bin/sh\r\necho\r\n"}, []string{"line-endings"}},
+		{"os requirements", ScriptParams{ScriptContents: "#!/bin/sh\n", OsRequirements: "12.x, 13.1, fourteen"}, []string{"os-requirements"}},
+		{"clean", ScriptParams{ScriptContents: "#!/bin/sh\necho hi\n", OsRequirements: "10.15.x,11.x"}, nil},
+	} {
+		var got []string
+		for _, f := range LintScript(tt.params) {
+			got = append(got, f.Rule)
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s: LintScript() rules = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package jamf_pro_go
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// ScriptArgument is the value passed to one parameter of a script by a
+// policy, along with the label the script gives to that parameter.
+type ScriptArgument struct {
+	Slot  int    `json:"slot"`
+	Label string `json:"label,omitempty"`
+	Value string `json:"value"`
+}
+
+// String renders the argument as label=value, or parameterN=value when the
+// slot has no label.
+func (a ScriptArgument) String() string {
+	return a.name() + "=" + a.Value
+}
+
+func (a ScriptArgument) name() string {
+	if a.Label == "" {
+		return fmt.Sprintf("parameter%d", a.Slot)
+	}
+	return a.Label
+}
+
+// Parameter returns the label of parameter n (4 to 11).
+func (s *Script) Parameter(n int) string {
+	if p := s.parameter(n); p != nil {
+		return *p
+	}
+	return ""
+}
+
+// parameter returns a pointer to the label of parameter n (4 to 11).
+func (s *Script) parameter(n int) *string {
+	switch n {
+	case 4:
+		return &s.Parameter4
+	case 5:
+		return &s.Parameter5
+	case 6:
+		return &s.Parameter6
+	case 7:
+		return &s.Parameter7
+	case 8:
+		return &s.Parameter8
+	case 9:
+		return &s.Parameter9
+	case 10:
+		return &s.Parameter10
+	case 11:
+		return &s.Parameter11
+	}
+	return nil
+}
+
+// Arguments returns the values the policy script passes to the script, in
+// slot order, each with the label of its slot. Empty values are left out.
+func (s *Script) Arguments(ps *PolicyScript) []ScriptArgument {
+	var args []ScriptArgument
+	for n := 4; n <= 11; n++ {
+		if v := ps.Parameter(n); v != "" {
+			args = append(args, ScriptArgument{Slot: n, Label: s.Parameter(n), Value: v})
+		}
+	}
+	return args
+}
+
+// FormatArguments renders the arguments of the policy script as
+// space-separated label=value pairs, e.g. `Version="1.2" Channel="stable"`.
+func (s *Script) FormatArguments(ps *PolicyScript) string {
+	args := s.Arguments(ps)
+	pairs := make([]string, 0, len(args))
+	for _, a := range args {
+		pairs = append(pairs, fmt.Sprintf("%s=%q", a.name(), a.Value))
+	}
+	return strings.Join(pairs, " ")
+}
+
+// PolicyScript returns a PolicyScript that runs the script with the given
+// values, keyed by parameter label. Labels are matched case-insensitively.
+// It fails if a label is not one of the script's, or if the script gives
+// the same label to several parameters.
+func (s *Script) PolicyScript(priority PolicyScriptPriority, values map[string]string) (*PolicyScript, error) {
+	scriptID, err := strconv.ParseUint(s.ID, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("[jamf-pro-go] invalid script ID %q: %w", s.ID, err)
+	}
+	ps := &PolicyScript{ID: uint32(scriptID), Name: s.Name, Priority: priority}
+
+	v := &ValidationError{}
+	slots := map[string]int{}
+	for n := 4; n <= 11; n++ {
+		label := s.Parameter(n)
+		if label == "" {
+			continue
+		}
+		if m, ok := slots[strings.ToLower(label)]; ok {
+			v.add(fmt.Sprintf("parameter%d", n), "script %q labels parameters %d and %d both %q", s.Name, m, n, label)
+			continue
+		}
+		slots[strings.ToLower(label)] = n
+	}
+
+	labels := make([]string, 0, len(values))
+	for label := range values {
+		labels = append(labels, label)
+	}
+	sort.Strings(labels)
+
+	for _, label := range labels {
+		n, ok := slots[strings.ToLower(label)]
+		if !ok {
+			v.add(fmt.Sprintf("parameters[%q]", label), "script %q has no parameter labelled %q", s.Name, label)
+			continue
+		}
+		ps.SetParameter(n, values[label])
+	}
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+// CheckArguments returns a *ValidationError if the policy script passes a
+// value to a parameter the script has no label for, which the script most
+// likely ignores.
+func (s *Script) CheckArguments(ps *PolicyScript) error {
+	v := &ValidationError{}
+	for _, a := range s.Arguments(ps) {
+		if a.Label == "" {
+			v.add(fmt.Sprintf("parameter%d", a.Slot), "script %q has no label for parameter %d, value %q is likely ignored", s.Name, a.Slot, a.Value)
+		}
+	}
+	return v.err()
+}
@@ -0,0 +1,80 @@
+package jamf_pro_go
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestScriptParameter(t *testing.T) {
+	s := &Script{Parameter4: "Version", Parameter11: "Channel"}
+	for n, want := range map[int]string{3: "", 4: "Version", 5: "", 11: "Channel", 12: ""} {
+		if got := s.Parameter(n); got != want {
+			t.Errorf("Parameter(%d) = %q, want %q", n, got, want)
+		}
+	}
+}
+
+func TestScriptArguments(t *testing.T) {
+	s := &Script{Name: "install.sh", Parameter4: "Version", Parameter6: "Channel"}
+	ps := &PolicyScript{Parameter4: "1.2", Parameter5: "x", Parameter6: "stable beta"}
+
+	want := []ScriptArgument{
+		{Slot: 4, Label: "Version", Value: "1.2"},
+		{Slot: 5, Value: "x"},
+		{Slot: 6, Label: "Channel", Value: "stable beta"},
+	}
+	if got := s.Arguments(ps); !reflect.DeepEqual(got, want) {
+		t.Errorf("Arguments() = %+v, want %+v", got, want)
+	}
+	if got, want := s.FormatArguments(ps), `Version="1.2" parameter5="x" Channel="stable beta"`; got != want {
+		t.Errorf("FormatArguments() = %s, want %s", got, want)
+	}
+
+	var v *ValidationError
+	if err := s.CheckArguments(ps); !errors.As(err, &v) || len(v.Problems) != 1 || v.Problems[0].Field != "parameter5" {
+		t.Errorf("CheckArguments() = %v, want a problem for parameter5", err)
+	}
+}
+
+func TestScriptPolicyScript(t *testing.T) {
+	s := &Script{ID: "41", Name: "install.sh", Parameter4: "Version", Parameter7: "Channel"}
+
+	ps, err := s.PolicyScript("Before", map[string]string{"version": "1.2", "CHANNEL": "stable"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &PolicyScript{ID: 41, Name: "install.sh", Priority: "Before", Parameter4: "1.2", Parameter7: "stable"}
+	if !reflect.DeepEqual(ps, want) {
+		t.Errorf("PolicyScript() = %+v, want %+v", ps, want)
+	}
+
+	for _, tt := range []struct {
+		name   string
+		script *Script
+		values map[string]string
+		fields []string
+	}{
+		{"unknown label", s, map[string]string{"Version": "1", "Mode": "x"}, []string{`parameters["Mode"]`}},
+		{"labels differing in case", &Script{ID: "41", Name: "install.sh", Parameter4: "Version", Parameter9: "VERSION"},
+			map[string]string{"Version": "1"}, []string{"parameter9"}},
+	} {
+		_, err := tt.script.PolicyScript("Before", tt.values)
+		var v *ValidationError
+		if !errors.As(err, &v) {
+			t.Errorf("%s: error = %v, want a *ValidationError", tt.name, err)
+			continue
+		}
+		var fields []string
+		for _, p := range v.Problems {
+			fields = append(fields, p.Field)
+		}
+		if !reflect.DeepEqual(fields, tt.fields) {
+			t.Errorf("%s: fields = %v, want %v", tt.name, fields, tt.fields)
+		}
+	}
+
+	if _, err := (&Script{ID: "x"}).PolicyScript("Before", nil); err == nil {
+		t.Error("PolicyScript() with an invalid ID succeeded")
+	}
+}
@@ -0,0 +1,279 @@
+package jamf_pro_go
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultScriptExtensions are the file extensions read by PlanScriptSync when
+// ScriptSyncOpts.Extensions is empty.
+var DefaultScriptExtensions = []string{".sh", ".bash", ".zsh", ".py", ".pl", ".rb", ".swift"}
+
+// ScriptMetadata is the optional sidecar file of a script, named after the
+// script file with a .json suffix, e.g. install.sh.json next to install.sh.
+// The category is given by ID or by name, which PlanScriptSync resolves to
+// its ID.
+//
+//	{
+//	  "info": "Installs the agent",
+//	  "priority": "AFTER",
+//	  "categoryId": "3",
+//	  "parameters": {"4": "Version", "5": "Channel"},
+//	  "osRequirements": "11.x, 12.x"
+//	}
+type ScriptMetadata struct {
+	Info           string            `json:"info,omitempty"`
+	Notes          string            `json:"notes,omitempty"`
+	Priority       ScriptPriority    `json:"priority,omitempty"`
+	CategoryID     string            `json:"categoryId,omitempty"`
+	CategoryName   string            `json:"categoryName,omitempty"`
+	Parameters     map[string]string `json:"parameters,omitempty"` // parameter labels by slot, "4" to "11"
+	OsRequirements string            `json:"osRequirements,omitempty"`
+}
+
+type ScriptSyncOpts struct {
+	// Extensions of the files treated as scripts. DefaultScriptExtensions if empty.
+	Extensions []string
+	// Delete deletes scripts that have no file in the directory.
+	Delete bool
+}
+
+type ScriptSyncAction string
+
+const (
+	ScriptSyncCreate    ScriptSyncAction = "create"
+	ScriptSyncUpdate    ScriptSyncAction = "update"
+	ScriptSyncDelete    ScriptSyncAction = "delete"
+	ScriptSyncUnchanged ScriptSyncAction = "unchanged"
+)
+
+// ScriptSyncStep is what ApplyScriptSync does for one script.
+type ScriptSyncStep struct {
+	Action ScriptSyncAction `json:"action"`
+	Name   string           `json:"name"`
+	// ScriptID is the ID of the existing script, empty for a create.
+	ScriptID string `json:"scriptId,omitempty"`
+	// File is the script file, empty for a delete.
+	File   string        `json:"file,omitempty"`
+	Params *ScriptParams `json:"-"`
+}
+
+// ScriptSyncPlan lists the steps that make the scripts of the Jamf Pro
+// server mirror a directory. Building a plan changes nothing, so it can be
+// reviewed as a dry run before it is applied.
+type ScriptSyncPlan struct {
+	Steps []ScriptSyncStep `json:"steps"`
+}
+
+// Changes reports whether applying the plan changes anything.
+func (p *ScriptSyncPlan) Changes() bool {
+	for _, s := range p.Steps {
+		if s.Action != ScriptSyncUnchanged {
+			return true
+		}
+	}
+	return false
+}
+
+// String renders the plan as one line per step.
+func (p *ScriptSyncPlan) String() string {
+	var b strings.Builder
+	for _, s := range p.Steps {
+		if s.ScriptID != "" {
+			fmt.Fprintf(&b, "%-9s %s (ID: %s)\n", s.Action, s.Name, s.ScriptID)
+		} else {
+			fmt.Fprintf(&b, "%-9s %s\n", s.Action, s.Name)
+		}
+	}
+	return b.String()
+}
+
+// PlanScriptSync compares the script files in dir with the scripts of the
+// server, matched by name (the file name), and returns the steps needed to
+// make the server mirror the directory.
+func (c *Client) PlanScriptSync(dir string, opts ScriptSyncOpts) (*ScriptSyncPlan, error) {
+	local, err := ReadScriptDir(dir, opts.Extensions)
+	if err != nil {
+		return nil, err
+	}
+
+	existing := map[string]Script{}
+	pager := c.PageScripts(PageOpts{})
+	for pager.Next() {
+		for _, s := range pager.Scripts() {
+			existing[s.Name] = s
+		}
+	}
+	if err := pager.Err(); err != nil {
+		return nil, err
+	}
+
+	categoryIDs := map[string]string{}
+	plan := &ScriptSyncPlan{Steps: []ScriptSyncStep{}}
+	for _, l := range local {
+		params := l.Params
+		if params.CategoryID == "" && params.CategoryName != "" {
+			// the API only uses the ID, so a name has to be resolved to compare
+			id, ok := categoryIDs[params.CategoryName]
+			if !ok {
+				if id, err = c.CategoryID(params.CategoryName); err != nil {
+					return nil, fmt.Errorf("%s: %w", l.File, err)
+				}
+				categoryIDs[params.CategoryName] = id
+			}
+			params.CategoryID = id
+		}
+		step := ScriptSyncStep{Name: params.Name, File: l.File, Params: &params}
+		s, ok := existing[params.Name]
+		switch {
+		case !ok:
+			step.Action = ScriptSyncCreate
+		case scriptMatches(&s, params):
+			step.Action = ScriptSyncUnchanged
+			step.ScriptID = s.ID
+		default:
+			step.Action = ScriptSyncUpdate
+			step.ScriptID = s.ID
+		}
+		delete(existing, params.Name)
+		plan.Steps = append(plan.Steps, step)
+	}
+
+	if opts.Delete {
+		names := make([]string, 0, len(existing))
+		for name := range existing {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			plan.Steps = append(plan.Steps, ScriptSyncStep{
+				Action:   ScriptSyncDelete,
+				Name:     name,
+				ScriptID: existing[name].ID,
+			})
+		}
+	}
+
+	return plan, nil
+}
+
+// ApplyScriptSync runs the steps of the plan. It stops at the first error.
+func (c *Client) ApplyScriptSync(plan *ScriptSyncPlan) error {
+	for _, s := range plan.Steps {
+		switch s.Action {
+		case ScriptSyncCreate:
+			if _, err := c.CreateScript(*s.Params); err != nil {
+				return fmt.Errorf("create script %q: %w", s.Name, err)
+			}
+		case ScriptSyncUpdate:
+			scriptID, err := strconv.ParseUint(s.ScriptID, 10, 32)
+			if err != nil {
+				return err
+			}
+			params := *s.Params
+			params.ID = s.ScriptID
+			if _, err := c.UpdateScript(uint32(scriptID), params); err != nil {
+				return fmt.Errorf("update script %q: %w", s.Name, err)
+			}
+		case ScriptSyncDelete:
+			scriptID, err := strconv.ParseUint(s.ScriptID, 10, 32)
+			if err != nil {
+				return err
+			}
+			if err := c.DeleteScript(uint32(scriptID)); err != nil {
+				return fmt.Errorf("delete script %q: %w", s.Name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// LocalScript is a script file read by ReadScriptDir.
+type LocalScript struct {
+	File   string
+	Params ScriptParams
+}
+
+// ReadScriptDir reads the script files of dir and their sidecar metadata.
+// Only the files with one of the extensions are read, DefaultScriptExtensions
+// if none is given.
+func ReadScriptDir(dir string, extensions []string) ([]LocalScript, error) {
+	if len(extensions) == 0 {
+		extensions = DefaultScriptExtensions
+	}
+
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var scripts []LocalScript
+	for _, e := range entries {
+		if e.IsDir() || strings.HasPrefix(e.Name(), ".") || !hasExtension(e.Name(), extensions) {
+			continue
+		}
+		file := filepath.Join(dir, e.Name())
+		contents, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+
+		params := ScriptParams{
+			Name:           e.Name(),
+			ScriptContents: string(contents),
+		}
+		if err := readScriptMetadata(file+".json", &params); err != nil {
+			return nil, err
+		}
+		scripts = append(scripts, LocalScript{File: file, Params: params})
+	}
+
+	return scripts, nil
+}
+
+func readScriptMetadata(file string, params *ScriptParams) error {
+	b, err := ioutil.ReadFile(file)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	var m ScriptMetadata
+	if err := json.Unmarshal(b, &m); err != nil {
+		return fmt.Errorf("%s: %w", file, err)
+	}
+
+	params.Info = m.Info
+	params.Notes = m.Notes
+	params.Priority = m.Priority
+	params.CategoryID = m.CategoryID
+	params.CategoryName = m.CategoryName
+	params.OsRequirements = m.OsRequirements
+	for slot, label := range m.Parameters {
+		n, err := strconv.Atoi(slot)
+		if err != nil || params.parameter(n) == nil {
+			return fmt.Errorf("%s: invalid parameter slot %q (4 to 11)", file, slot)
+		}
+		*params.parameter(n) = label
+	}
+
+	return nil
+}
+
+func hasExtension(name string, extensions []string) bool {
+	ext := strings.ToLower(filepath.Ext(name))
+	for _, e := range extensions {
+		if strings.ToLower(e) == ext {
+			return true
+		}
+	}
+	return false
+}
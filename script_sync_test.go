@@ -0,0 +1,75 @@
+package jamf_pro_go
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPlanScriptSyncResolvesCategoryName(t *testing.T) {
+	dir, err := ioutil.TempDir("", "script-sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"install.sh":      "#!/bin/bash\necho install\n",
+		"install.sh.json": `{"categoryName": "Tools"}`,
+		"update.sh":       "#!/bin/bash\necho update\n",
+		"update.sh.json":  `{"categoryName": "Tools", "info": "changed"}`,
+	}
+	for name, contents := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	categoryLookups := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/uapi/v1/scripts":
+			fmt.Fprint(w, `{"totalCount": 2, "results": [
+				{"id": "1", "name": "install.sh", "priority": "BEFORE", "categoryId": "3", "categoryName": "Tools", "scriptContents": "#!/bin/bash\necho install\n"},
+				{"id": "2", "name": "update.sh", "priority": "BEFORE", "categoryId": "3", "categoryName": "Tools", "scriptContents": "#!/bin/bash\necho update\n"}
+			]}`)
+		case "/uapi/v1/categories":
+			categoryLookups++
+			if got, want := r.URL.Query().Get("filter"), `name=="Tools"`; got != want {
+				t.Errorf("category filter = %s, want %s", got, want)
+			}
+			fmt.Fprint(w, `{"totalCount": 1, "results": [{"id": "3", "name": "Tools", "priority": 9}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	c := NewClient(&Config{BaseURL: srv.URL})
+	plan, err := c.PlanScriptSync(dir, ScriptSyncOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	actions := map[string]ScriptSyncAction{}
+	for _, s := range plan.Steps {
+		actions[s.Name] = s.Action
+		if s.Params.CategoryID != "3" {
+			t.Errorf("%s: categoryId = %q, want 3", s.Name, s.Params.CategoryID)
+		}
+	}
+	if actions["install.sh"] != ScriptSyncUnchanged {
+		t.Errorf("install.sh: %s, want %s", actions["install.sh"], ScriptSyncUnchanged)
+	}
+	if actions["update.sh"] != ScriptSyncUpdate {
+		t.Errorf("update.sh: %s, want %s", actions["update.sh"], ScriptSyncUpdate)
+	}
+	if categoryLookups != 1 {
+		t.Errorf("category looked up %d times, want 1", categoryLookups)
+	}
+}
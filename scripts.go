@@ -3,8 +3,12 @@ package jamf_pro_go
 import (
 	"fmt"
 	"io"
+	"io/ioutil"
+	"mime"
 	"net/http"
+	"os"
 	"path"
+	"path/filepath"
 
 	"github.com/google/go-querystring/query"
 )
@@ -24,7 +28,7 @@ type Script struct {
 	Name            string `json:"name"`
 	Info            string `json:"info"`
 	Notes           string `json:"notes"`
-	Priority        string `json:"priority"` // [ BEFORE, AFTER, AT_REBOOT ] (default: BEFORE)
+	Priority        ScriptPriority `json:"priority"` // default: BEFORE
 	CategoryID      string `json:"categoryId"`
 	CategoryName    string `json:"categoryName"`
 	Parameter4      string `json:"parameter4"`
@@ -40,12 +44,7 @@ type Script struct {
 }
 
 
-type GetScriptsOpts struct {
-	Page      uint32   `url:"page,omitempty"`
-	PageSize  uint32   `url:"page-size,omitempty"`
-	Sort      []string `url:"sort,omitempty"`
-	Filter    string   `url:"filter,omitempty"`
-}
+type GetScriptsOpts = PageOpts
 
 func (c *Client) GetScripts(opts GetScriptsOpts) (*Scripts, error) {
 	var result Scripts
@@ -64,6 +63,33 @@ func (c *Client) GetScripts(opts GetScriptsOpts) (*Scripts, error) {
 	return &result, nil
 }
 
+type ScriptPager struct {
+	*Pager
+	page *Scripts
+}
+
+// Scripts returns the scripts of the current page.
+func (p *ScriptPager) Scripts() []Script {
+	if p.page == nil {
+		return nil
+	}
+	return p.page.Results
+}
+
+// PageScripts returns a pager over all scripts matching opts.
+func (c *Client) PageScripts(opts PageOpts) *ScriptPager {
+	p := &ScriptPager{}
+	p.Pager = newPager(opts, func(opts PageOpts) (int, uint32, error) {
+		page, err := c.GetScripts(opts)
+		if err != nil {
+			return 0, 0, err
+		}
+		p.page = page
+		return len(page.Results), page.TotalCount, nil
+	})
+	return p
+}
+
 func (c *Client) GetScript(scriptID uint32) (*Script, error) {
 	var result Script
 
@@ -86,7 +112,7 @@ type ScriptParams struct {
 	Name            string `json:"name"`
 	Info            string `json:"info,omitempty"`
 	Notes           string `json:"notes,omitempty"`
-	Priority        string `json:"priority,omitempty"` // [ BEFORE, AFTER, AT_REBOOT ] (default: BEFORE)
+	Priority        ScriptPriority `json:"priority,omitempty"` // default: BEFORE
 	CategoryID      string `json:"categoryId"`
 	CategoryName    string `json:"categoryName,omitempty"`
 	Parameter4      string `json:"parameter4,omitempty"`
@@ -101,9 +127,64 @@ type ScriptParams struct {
 	ScriptContents  string `json:"scriptContents,omitempty"`
 }
 
+// params returns the script as the params to create or update it.
+func (s *Script) params() ScriptParams {
+	return ScriptParams{
+		ID:             s.ID,
+		Name:           s.Name,
+		Info:           s.Info,
+		Notes:          s.Notes,
+		Priority:       s.Priority,
+		CategoryID:     s.CategoryID,
+		CategoryName:   s.CategoryName,
+		Parameter4:     s.Parameter4,
+		Parameter5:     s.Parameter5,
+		Parameter6:     s.Parameter6,
+		Parameter7:     s.Parameter7,
+		Parameter8:     s.Parameter8,
+		Parameter9:     s.Parameter9,
+		Parameter10:    s.Parameter10,
+		Parameter11:    s.Parameter11,
+		OsRequirements: s.OsRequirements,
+		ScriptContents: s.ScriptContents,
+	}
+}
+
+// parameter returns a pointer to the label of parameter n (4 to 11).
+func (p *ScriptParams) parameter(n int) *string {
+	switch n {
+	case 4:
+		return &p.Parameter4
+	case 5:
+		return &p.Parameter5
+	case 6:
+		return &p.Parameter6
+	case 7:
+		return &p.Parameter7
+	case 8:
+		return &p.Parameter8
+	case 9:
+		return &p.Parameter9
+	case 10:
+		return &p.Parameter10
+	case 11:
+		return &p.Parameter11
+	}
+	return nil
+}
+
 func (c *Client) CreateScript (params ScriptParams) (*CreateScriptResult, error) {
 	var result CreateScriptResult
 
+	if err := validateEnums(params); err != nil {
+		return nil, err
+	}
+	if c.config.LintScripts {
+		if err := lintErrors(params.Name, LintScript(params)); err != nil {
+			return nil, err
+		}
+	}
+
 	err := c.call(path.Join(APIVersionScripts, APIPathScripts), http.MethodPost,
 		APIVersionScripts, nil, params, &result)
 	if err != nil {
@@ -116,6 +197,15 @@ func (c *Client) CreateScript (params ScriptParams) (*CreateScriptResult, error)
 func (c *Client) UpdateScript (scriptID uint32, params ScriptParams) (*Script, error) {
 	var result Script
 
+	if err := validateEnums(params); err != nil {
+		return nil, err
+	}
+	if c.config.LintScripts {
+		if err := lintErrors(params.Name, LintScript(params)); err != nil {
+			return nil, err
+		}
+	}
+
 	err := c.call(path.Join(APIVersionScripts, APIPathScripts, fmt.Sprint(scriptID)), http.MethodPut,
 		APIVersionScripts, nil, params, &result)
 	if err != nil {
@@ -134,4 +224,135 @@ func (c *Client) DeleteScript (scriptID uint32) error {
 	fmt.Println("[jamf-pro-go] Script (ID: ", scriptID, ") is deleted")
 
 	return nil
-}
\ No newline at end of file
+}
+
+func (c *Client) GetScriptHistory(scriptID uint32, opts PageOpts) (*History, error) {
+	var result History
+
+	v, err := query.Values(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.call(path.Join(APIVersionScripts, APIPathScripts, fmt.Sprint(scriptID), "history"), http.MethodGet,
+		APIVersionScripts, v, nil, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// PageScriptHistory returns a pager over the history of the script.
+func (c *Client) PageScriptHistory(scriptID uint32, opts PageOpts) *HistoryPager {
+	return newHistoryPager(opts, func(opts PageOpts) (*History, error) {
+		return c.GetScriptHistory(scriptID, opts)
+	})
+}
+
+func (c *Client) AddScriptHistoryNote(scriptID uint32, note string) (*CreateHistoryNoteResult, error) {
+	var result CreateHistoryNoteResult
+
+	err := c.call(path.Join(APIVersionScripts, APIPathScripts, fmt.Sprint(scriptID), "history"), http.MethodPost,
+		APIVersionScripts, nil, HistoryNoteParams{Note: note}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// DownloadScript returns the script file as plain text.
+// The caller must close the returned reader.
+func (c *Client) DownloadScript(scriptID uint32) (io.ReadCloser, error) {
+	response, err := c.downloadScript(scriptID)
+	if err != nil {
+		return nil, err
+	}
+	return response.Body, nil
+}
+
+// DownloadScriptToFile writes the script file into dir, named after the
+// script, and returns the path of the file. The file is written under a
+// temporary name first, so that a failed download leaves no partial file
+// and does not change an existing one.
+func (c *Client) DownloadScriptToFile(scriptID uint32, dir string) (string, error) {
+	response, err := c.downloadScript(scriptID)
+	if err != nil {
+		return "", err
+	}
+	defer response.Body.Close()
+
+	name := ""
+	if _, params, err := mime.ParseMediaType(response.Header.Get("Content-Disposition")); err == nil {
+		name = params["filename"]
+	}
+	if name == "" {
+		script, err := c.GetScript(scriptID)
+		if err != nil {
+			return "", err
+		}
+		name = script.Name
+	}
+	// never write outside of dir
+	name = filepath.Base(filepath.Clean("/" + name))
+	if name == "/" || name == "." {
+		name = fmt.Sprintf("script-%d", scriptID)
+	}
+
+	filePath := filepath.Join(dir, name)
+	f, err := ioutil.TempFile(dir, "."+name+".*")
+	if err != nil {
+		return "", err
+	}
+	if err := writeScriptFile(f, response.Body); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	if err := os.Rename(f.Name(), filePath); err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+
+	return filePath, nil
+}
+
+// writeScriptFile copies the script into f and closes it. Temporary files
+// are only readable by their owner, scripts are readable by everyone.
+func writeScriptFile(f *os.File, r io.Reader) error {
+	if _, err := io.Copy(f, r); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Chmod(0644); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+func (c *Client) downloadScript(scriptID uint32) (*http.Response, error) {
+	return c.stream(path.Join(APIVersionScripts, APIPathScripts, fmt.Sprint(scriptID), "download"), http.MethodGet,
+		APIVersionScripts, "text/plain", nil, nil)
+}
+
+// ExportScripts returns the scripts matching opts as CSV.
+// The caller must close the returned reader.
+func (c *Client) ExportScripts(opts ExportOpts) (io.ReadCloser, error) {
+	response, err := c.stream(path.Join(APIVersionScripts, APIPathScripts, "export"), http.MethodPost,
+		APIVersionScripts, "text/csv", nil, opts)
+	if err != nil {
+		return nil, err
+	}
+	return response.Body, nil
+}
+
+// ExportScriptsRecords returns the scripts matching opts as CSV records.
+// The first record holds the column labels.
+func (c *Client) ExportScriptsRecords(opts ExportOpts) ([][]string, error) {
+	r, err := c.ExportScripts(opts)
+	if err != nil {
+		return nil, err
+	}
+	return readCSVRecords(r)
+}
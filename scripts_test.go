@@ -0,0 +1,96 @@
+package jamf_pro_go
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDownloadScriptToFile(t *testing.T) {
+	disposition := ""
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/uapi/v1/scripts/41/download":
+			if disposition != "" {
+				w.Header().Set("Content-Disposition", disposition)
+			}
+			w.Write([]byte("#!/bin/sh\necho hi\n"))
+		case "/uapi/v1/scripts/41":
+			w.Write([]byte(`{"id":"41","name":"from-api.sh"}`))
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	c := NewClient(&Config{BaseURL: srv.URL})
+
+	for _, tt := range []struct {
+		disposition string
+		want        string
+	}{
+		{`attachment; filename="install.sh"`, "install.sh"},
+		{`attachment; filename="../../etc/install.sh"`, "install.sh"},
+		{`attachment; filename="/abs/install.sh"`, "install.sh"},
+		{`attachment; filename=".."`, "script-41"},
+		{`attachment; filename="/"`, "script-41"},
+		{"", "from-api.sh"},
+		{"invalid;;", "from-api.sh"},
+	} {
+		dir, err := ioutil.TempDir("", "scripts")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		disposition = tt.disposition
+		got, err := c.DownloadScriptToFile(41, dir)
+		if err != nil {
+			t.Errorf("%q: %v", tt.disposition, err)
+			continue
+		}
+		if want := filepath.Join(dir, tt.want); got != want {
+			t.Errorf("%q: path = %s, want %s", tt.disposition, got, want)
+		}
+		if b, err := ioutil.ReadFile(got); err != nil || string(b) != "#!/bin/sh\necho hi\n" {
+			t.Errorf("%q: content = %q, %v", tt.disposition, b, err)
+		}
+		if files, _ := ioutil.ReadDir(dir); len(files) != 1 || files[0].Mode().Perm() != 0644 {
+			t.Errorf("%q: files in dir = %v, want one readable by everyone", tt.disposition, files)
+		}
+	}
+}
+
+func TestDownloadScriptToFileFailure(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// the connection is closed before the announced length is sent
+		w.Header().Set("Content-Disposition", `attachment; filename="install.sh"`)
+		w.Header().Set("Content-Length", "100")
+		w.Write([]byte("#!/bin/sh\n"))
+	}))
+	defer srv.Close()
+	c := NewClient(&Config{BaseURL: srv.URL})
+
+	dir, err := ioutil.TempDir("", "scripts")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	existing := filepath.Join(dir, "install.sh")
+	if err := ioutil.WriteFile(existing, []byte("old"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.DownloadScriptToFile(41, dir); err == nil {
+		t.Fatal("DownloadScriptToFile() of a truncated script succeeded")
+	}
+	if b, err := ioutil.ReadFile(existing); err != nil || string(b) != "old" {
+		t.Errorf("existing file = %q, %v, want it unchanged", b, err)
+	}
+	if files, _ := ioutil.ReadDir(dir); len(files) != 1 {
+		t.Errorf("%d files in dir, want only the existing one", len(files))
+	}
+}
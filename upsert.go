@@ -0,0 +1,221 @@
+package jamf_pro_go
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+type UpsertAction string
+
+const (
+	UpsertCreated   UpsertAction = "created"
+	UpsertUpdated   UpsertAction = "updated"
+	UpsertUnchanged UpsertAction = "unchanged"
+)
+
+type UpsertScriptResult struct {
+	Action UpsertAction
+	ID     string
+}
+
+// UpsertScript creates the script if no script has the same name, updates it
+// if its content differs, and does nothing otherwise.
+// A category given by CategoryName only is resolved to its ID first.
+func (c *Client) UpsertScript(params ScriptParams) (*UpsertScriptResult, error) {
+	if len(params.Name) == 0 {
+		return nil, errors.New("[Err] missing script name")
+	}
+
+	if params.CategoryID == "" && params.CategoryName != "" {
+		// the API only uses the ID, so a name has to be resolved to compare
+		id, err := c.CategoryID(params.CategoryName)
+		if err != nil {
+			return nil, err
+		}
+		params.CategoryID = id
+	}
+
+	existing, err := c.findScriptByName(params.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		created, err := c.CreateScript(params)
+		if err != nil {
+			return nil, err
+		}
+		return &UpsertScriptResult{Action: UpsertCreated, ID: created.ID}, nil
+	}
+
+	if scriptMatches(existing, params) {
+		return &UpsertScriptResult{Action: UpsertUnchanged, ID: existing.ID}, nil
+	}
+
+	scriptID, err := strconv.ParseUint(existing.ID, 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	params.ID = existing.ID
+	if _, err := c.UpdateScript(uint32(scriptID), params); err != nil {
+		return nil, err
+	}
+	return &UpsertScriptResult{Action: UpsertUpdated, ID: existing.ID}, nil
+}
+
+// findScriptByName returns the script with the given name, or nil if there is none.
+func (c *Client) findScriptByName(name string) (*Script, error) {
+	scripts, err := c.GetScripts(GetScriptsOpts{
+		PageSize: 2,
+		Filter:   "name==" + rsqlQuote(name),
+	})
+	if err != nil {
+		return nil, err
+	}
+	switch len(scripts.Results) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &scripts.Results[0], nil
+	}
+	return nil, fmt.Errorf("[jamf-pro-go] more than one script is named %q", name)
+}
+
+// scriptMatches reports whether the script already has the content of params.
+func scriptMatches(s *Script, params ScriptParams) bool {
+	existing := normalizeScriptParams(s.params())
+	return existing == normalizeScriptParams(params)
+}
+
+// normalizeScriptParams fills in the defaults the server applies, so that
+// params can be compared with an existing script.
+func normalizeScriptParams(p ScriptParams) ScriptParams {
+	p.ID = ""
+	p.CategoryName = ""
+	if p.CategoryID == "" {
+		p.CategoryID = "-1"
+	}
+	priority, _ := normalizeEnum("", string(p.Priority), scriptPriorities)
+	if priority == "" {
+		priority = string(ScriptPriorityBefore)
+	}
+	p.Priority = ScriptPriority(priority)
+	return p
+}
+
+// rsqlQuote quotes a value for use in an RSQL filter.
+func rsqlQuote(v string) string {
+	v = strings.ReplaceAll(v, `\`, `\\`)
+	v = strings.ReplaceAll(v, `"`, `\"`)
+	return `"` + v + `"`
+}
+
+type UpsertPolicyResult struct {
+	Action UpsertAction
+	ID     uint32
+	// Diff holds the changes applied by an update.
+	Diff *Diff
+}
+
+// UpsertPolicy creates the policy if no policy has the same name, updates it
+// if any of the fields set in policy differs, and does nothing otherwise.
+// Fields left unset in policy are not compared.
+func (c *Client) UpsertPolicy(policy *Policy) (*UpsertPolicyResult, error) {
+	if policy.General == nil || len(policy.General.Name) == 0 {
+		return nil, errors.New("[Err] missing policy name")
+	}
+
+	existing, err := c.GetPolicyByName(policy.General.Name)
+	if err != nil {
+		var apiErr *Error
+		if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusNotFound {
+			return nil, err
+		}
+		created, err := c.CreatePolicy(policy)
+		if err != nil {
+			return nil, err
+		}
+		return &UpsertPolicyResult{Action: UpsertCreated, ID: created.ID}, nil
+	}
+
+	if existing.General == nil {
+		return nil, fmt.Errorf("[jamf-pro-go] policy %q has no general section", policy.General.Name)
+	}
+	policyID := existing.General.ID
+	merged, err := existing.Copy()
+	if err != nil {
+		return nil, err
+	}
+	overlay(reflect.ValueOf(merged).Elem(), reflect.ValueOf(policy).Elem())
+
+	diff := DiffPolicies(existing, merged)
+	if diff.Empty() {
+		return &UpsertPolicyResult{Action: UpsertUnchanged, ID: policyID}, nil
+	}
+
+	if _, err := c.UpdatePolicy(policyID, policy); err != nil {
+		return nil, err
+	}
+	return &UpsertPolicyResult{Action: UpsertUpdated, ID: policyID, Diff: diff}, nil
+}
+
+// overlay copies every field that is set in src onto dst.
+// Lists set in src replace the lists of dst, but items found in both lists
+// (by ID or name) are overlaid as well.
+func overlay(dst, src reflect.Value) {
+	for i := 0; i < src.NumField(); i++ {
+		if src.Type().Field(i).Name == "XMLName" {
+			continue
+		}
+		s, d := src.Field(i), dst.Field(i)
+		switch s.Kind() {
+		case reflect.Ptr:
+			if s.IsNil() {
+				continue
+			}
+			if d.IsNil() || s.Elem().Kind() != reflect.Struct {
+				d.Set(s)
+				continue
+			}
+			overlay(d.Elem(), s.Elem())
+		case reflect.Slice:
+			if s.Len() > 0 {
+				d.Set(overlayList(d, s))
+			}
+		default:
+			if !s.IsZero() {
+				d.Set(s)
+			}
+		}
+	}
+}
+
+func overlayList(dst, src reflect.Value) reflect.Value {
+	if src.Type().Elem().Kind() != reflect.Ptr || src.Type().Elem().Elem().Kind() != reflect.Struct {
+		return src
+	}
+	dstKeys, dstOK := listKeys(dst)
+	srcKeys, srcOK := listKeys(src)
+	if !dstOK || !srcOK {
+		return src
+	}
+
+	pairs := matchListKeys(dstKeys, srcKeys)
+	result := reflect.MakeSlice(src.Type(), src.Len(), src.Len())
+	for i := range srcKeys {
+		item := src.Index(i)
+		if j, ok := pairs[i]; ok {
+			d := dst.Index(j)
+			merged := reflect.New(d.Elem().Type())
+			merged.Elem().Set(d.Elem())
+			overlay(merged.Elem(), item.Elem())
+			item = merged
+		}
+		result.Index(i).Set(item)
+	}
+	return result
+}
@@ -0,0 +1,71 @@
+package jamf_pro_go
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestUpsertScript(t *testing.T) {
+	var created, updated []ScriptParams
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/uapi/v1/scripts":
+			if r.URL.Query().Get("filter") == `name=="new.sh"` {
+				fmt.Fprint(w, `{"totalCount": 0, "results": []}`)
+				return
+			}
+			fmt.Fprint(w, `{"totalCount": 1, "results": [
+				{"id": "5", "name": "a.sh", "priority": "BEFORE", "categoryId": "3", "categoryName": "Tools", "scriptContents": "#!/bin/bash\necho a\n"}
+			]}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/uapi/v1/categories":
+			fmt.Fprint(w, `{"totalCount": 1, "results": [{"id": "3", "name": "Tools", "priority": 9}]}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/uapi/v1/scripts":
+			var p ScriptParams
+			json.NewDecoder(r.Body).Decode(&p)
+			created = append(created, p)
+			fmt.Fprint(w, `{"id": "6", "href": ""}`)
+		case r.Method == http.MethodPut && r.URL.Path == "/uapi/v1/scripts/5":
+			var p ScriptParams
+			json.NewDecoder(r.Body).Decode(&p)
+			updated = append(updated, p)
+			fmt.Fprint(w, `{"id": "5"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+	c := NewClient(&Config{BaseURL: srv.URL})
+
+	for _, tt := range []struct {
+		name   string
+		params ScriptParams
+		want   UpsertAction
+	}{
+		{"category by name", ScriptParams{Name: "a.sh", CategoryName: "Tools", ScriptContents: "#!/bin/bash\necho a\n"}, UpsertUnchanged},
+		{"category by name again", ScriptParams{Name: "a.sh", CategoryName: "Tools", ScriptContents: "#!/bin/bash\necho a\n"}, UpsertUnchanged},
+		{"category by ID", ScriptParams{Name: "a.sh", CategoryID: "3", Priority: "before", ScriptContents: "#!/bin/bash\necho a\n"}, UpsertUnchanged},
+		{"no category", ScriptParams{Name: "a.sh", ScriptContents: "#!/bin/bash\necho a\n"}, UpsertUpdated},
+		{"changed contents", ScriptParams{Name: "a.sh", CategoryName: "Tools", ScriptContents: "#!/bin/bash\necho b\n"}, UpsertUpdated},
+		{"new script", ScriptParams{Name: "new.sh", CategoryName: "Tools", ScriptContents: "#!/bin/bash\n"}, UpsertCreated},
+	} {
+		result, err := c.UpsertScript(tt.params)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if result.Action != tt.want {
+			t.Errorf("%s: %s, want %s", tt.name, result.Action, tt.want)
+		}
+	}
+
+	if len(updated) != 2 || len(created) != 1 {
+		t.Fatalf("%d updates and %d creations, want 2 and 1", len(updated), len(created))
+	}
+	if updated[1].CategoryID != "3" || created[0].CategoryID != "3" {
+		t.Errorf("categoryId sent = %q and %q, want 3", updated[1].CategoryID, created[0].CategoryID)
+	}
+}
@@ -0,0 +1,13 @@
+package jamf_pro_go
+
+import "encoding/xml"
+
+// RawXMLElement holds a classic API element that is not modelled by this
+// library. Such elements are collected while decoding and written back
+// unchanged while encoding, so that a read-modify-write of an object does
+// not lose settings the library does not know about.
+type RawXMLElement struct {
+	XMLName  xml.Name
+	Attrs    []xml.Attr `xml:",any,attr"`
+	InnerXML []byte     `xml:",innerxml"`
+}
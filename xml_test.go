@@ -0,0 +1,52 @@
+package jamf_pro_go
+
+import (
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+func TestUnknownElementsRoundTrip(t *testing.T) {
+	in := `<policy>` +
+		`<general><id>1</id><name>p</name><x_note>one</x_note><x_note>two</x_note></general>` +
+		`<scope><all_computers>true</all_computers><x_scope kind="lab" level="2"><nested>v</nested></x_scope></scope>` +
+		`<x_top enabled="true">top</x_top>` +
+		`</policy>`
+
+	var p Policy
+	if err := xml.Unmarshal([]byte(in), &p); err != nil {
+		t.Fatal(err)
+	}
+	if n := len(p.General.UnknownElements); n != 2 {
+		t.Errorf("general: %d unknown elements, want 2", n)
+	}
+	if n := len(p.Scope.UnknownElements); n != 1 {
+		t.Errorf("scope: %d unknown elements, want 1", n)
+	}
+	if n := len(p.UnknownElements); n != 1 {
+		t.Errorf("policy: %d unknown elements, want 1", n)
+	}
+
+	b, err := xml.Marshal(&p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(b)
+	for _, want := range []string{
+		`<x_note>one</x_note><x_note>two</x_note></general>`,
+		`<x_scope kind="lab" level="2"><nested>v</nested></x_scope></scope>`,
+		`<x_top enabled="true">top</x_top></policy>`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("encoded policy has no %s:\n%s", want, out)
+		}
+	}
+
+	var again Policy
+	if err := xml.Unmarshal(b, &again); err != nil {
+		t.Fatal(err)
+	}
+	if d := DiffPolicies(&p, &again); !d.Empty() {
+		t.Errorf("round trip changed the policy:\n%s", d)
+	}
+}